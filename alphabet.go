@@ -0,0 +1,261 @@
+package base32
+
+import (
+	"errors"
+	"fmt"
+)
+
+// An Alphabet is a set of 32 digits used to write base-32 numbers, plus a table
+// of aliases that are read as one of those digits.
+//
+// The package-level functions (Encode, FromString, Trim, and the Base32
+// methods) always use Crockford's alphabet. Use an Alphabet's methods to work
+// with one of the other common base-32 alphabets:
+//
+//	code := ZBase32.Encode(90) //=> "n4"
+//
+// Alphabet methods work with plain strings rather than Base32 values, since the
+// Base32 methods would misread digits written in another alphabet.
+//
+// Alphabets are read-only and safe for concurrent use.
+type Alphabet struct {
+	encode [32]byte
+	decode [256]byte // Digit value, or invalidAlphabetValue.
+}
+
+// Marks bytes that aren't digits in an Alphabet's decode table.
+const invalidAlphabetValue = 0xFF
+
+// Predefined alphabets.
+var (
+	// Crockford is Douglas Crockford's alphabet, which the rest of the
+	// package uses. The letters O, I and L are aliases for 0, 1 and 1. The
+	// package-level tables are built from it.
+	Crockford = MustAlphabet("0123456789ABCDEFGHJKMNPQRSTVWXYZ", map[byte]byte{
+		'O': '0',
+		'I': '1',
+		'L': '1',
+	})
+
+	// RFC4648 is the "base32" alphabet from RFC 4648, as used by the
+	// encoding/base32 package in the Go standard library.
+	RFC4648 = MustAlphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567", nil)
+
+	// Base32Hex is the "base32hex" alphabet from RFC 4648, whose digits sort
+	// in the same order as their values.
+	Base32Hex = MustAlphabet("0123456789ABCDEFGHIJKLMNOPQRSTUV", nil)
+
+	// ZBase32 is Zooko's human-oriented z-base-32 alphabet.
+	ZBase32 = MustAlphabet("ybndrfg8ejkmcpqxot1uwisza345h769", nil)
+
+	// Geohash is the alphabet used by geohashes.
+	Geohash = MustAlphabet("0123456789bcdefghjkmnpqrstuvwxyz", nil)
+)
+
+var alphabetLength = errors.New("An alphabet must have exactly 32 digits")
+
+// NewAlphabet returns an Alphabet made of the 32 digits in `digits`, in order
+// of value. `aliases` maps extra input characters to the digit they should be
+// read as.
+//
+// Letters are case insensitive, unless the alphabet uses both the uppercase and
+// lowercase form of the same letter. Encoded values always use the case of
+// `digits`.
+//
+// An error is returned if `digits` isn't 32 distinct ASCII characters, if a
+// digit is a hyphen (which is reserved as a separator), or if an alias is
+// already a digit or maps to something that isn't a digit.
+func NewAlphabet(digits string, aliases map[byte]byte) (*Alphabet, error) {
+
+	if len(digits) != 32 {
+		return nil, alphabetLength
+	}
+
+	var alphabet = new(Alphabet)
+	for i := range alphabet.decode {
+		alphabet.decode[i] = invalidAlphabetValue
+	}
+
+	for i := 0; i < len(digits); i++ {
+		char := digits[i]
+		if char <= ' ' || char > '~' || char == '-' {
+			return nil, fmt.Errorf("Invalid alphabet digit %q", char)
+		}
+		if alphabet.decode[char] != invalidAlphabetValue {
+			return nil, fmt.Errorf("Duplicate alphabet digit %q", char)
+		}
+		alphabet.encode[i] = char
+		alphabet.decode[char] = byte(i)
+	}
+
+	for alias, char := range aliases {
+		if alphabet.decode[alias] != invalidAlphabetValue || alias == '-' {
+			return nil, fmt.Errorf("Alias %q is already an alphabet digit", alias)
+		}
+		if alphabet.decode[char] == invalidAlphabetValue {
+			return nil, fmt.Errorf("Alias %q is for %q, which isn't an alphabet digit", alias, char)
+		}
+		alphabet.decode[alias] = alphabet.decode[char]
+	}
+
+	// Add the other case of each letter, unless it's already taken.
+	for char := 0; char < len(alphabet.decode); char++ {
+		var other int
+		switch {
+		case char >= 'a' && char <= 'z':
+			other = char - 32
+		case char >= 'A' && char <= 'Z':
+			other = char + 32
+		default:
+			continue
+		}
+		if alphabet.decode[char] != invalidAlphabetValue && alphabet.decode[other] == invalidAlphabetValue {
+			alphabet.decode[other] = alphabet.decode[char]
+		}
+	}
+
+	return alphabet, nil
+}
+
+// MustAlphabet is like NewAlphabet but panics if the alphabet is invalid. It is
+// meant for initializing package-level variables.
+func MustAlphabet(digits string, aliases map[byte]byte) *Alphabet {
+	alphabet, err := NewAlphabet(digits, aliases)
+	if err != nil {
+		panic(err)
+	}
+	return alphabet
+}
+
+// Encode translates a base-10 number into a base-32 string written in this
+// alphabet. See the package-level Encode for details.
+func (a *Alphabet) Encode(num uint32) string {
+	var buffer [7]byte
+
+	const fiveOnes uint32 = 31 // Binary 11111
+
+	// Fill the buffer from the least significant digit, then slice off the
+	// leading zeros. The result is always at least one digit.
+	var i = len(buffer)
+	for {
+		i--
+		buffer[i] = a.encode[num&fiveOnes]
+		num >>= 5
+		if num == 0 {
+			break
+		}
+	}
+
+	return string(buffer[i:])
+}
+
+// Decode translates a base-32 number written in this alphabet into a base-10
+// integer. Aliases are accepted in place of the digits they stand for, and
+// leading zeros are allowed. See Base32.Decode for details.
+func (a *Alphabet) Decode(num string) (result uint32, err error) {
+
+	if len(num) == 0 {
+		return 0, decodeEmptyString
+	}
+
+	for i := 0; i < len(num); i++ {
+		val := a.decode[num[i]]
+		if val == invalidAlphabetValue {
+			return 0, decodeInvalidDigit
+		}
+
+		// Shifting out any of the top 5 bits means the value doesn't fit.
+		if result>>27 != 0 {
+			return 0, decodeTooBig32
+		}
+
+		result = result<<5 | uint32(val)
+	}
+
+	return result, nil
+}
+
+// FromString normalizes a base32-like string written in this alphabet, if
+// possible. It replaces aliases with the digits they stand for, fixes the case
+// of letters, removes hyphens, and trims leading zeros. See the package-level
+// FromString for details. The empty string is returned along with any error.
+func (a *Alphabet) FromString(base32String string) (string, error) {
+
+	if len(base32String) == 0 {
+		return "", decodeEmptyString
+	}
+
+	var result = make([]byte, 0, len(base32String))
+	var sawDigit bool
+
+	for i := 0; i < len(base32String); i++ {
+		char := base32String[i]
+		if char == '-' {
+			continue
+		}
+
+		val := a.decode[char]
+		if val == invalidAlphabetValue {
+			return "", decodeInvalidDigit
+		}
+		sawDigit = true
+
+		// Skip leading zeros.
+		if val == 0 && len(result) == 0 {
+			continue
+		}
+
+		result = append(result, a.encode[val])
+	}
+
+	// The input was only hyphens.
+	if !sawDigit {
+		return "", decodeEmptyString
+	}
+
+	// The input was all zeros.
+	if len(result) == 0 {
+		return string(a.encode[:1]), nil
+	}
+
+	return string(result), nil
+}
+
+// Pad left-pads `num` with this alphabet's zero digit until the resulting
+// string is at least `n` characters wide. See Base32.Pad for details.
+func (a *Alphabet) Pad(num string, n uint8) []byte {
+	finalWidth := int(n)
+	inputLength := len(num)
+
+	if inputLength >= finalWidth {
+		return []byte(num)
+	}
+
+	var start = finalWidth - inputLength
+	var result = make([]byte, finalWidth)
+
+	for i := 0; i < start; i++ {
+		result[i] = a.encode[0]
+	}
+	copy(result[start:], num)
+
+	return result
+}
+
+// Trim removes zeros (this alphabet's zero digit, its aliases, and hyphens)
+// from the beginning of the argument. See the package-level Trim for details.
+// The empty string trims down to the empty string.
+func (a *Alphabet) Trim(padded string) string {
+	for i := 0; i < len(padded); i++ {
+		char := padded[i]
+		if char != '-' && a.decode[char] != 0 {
+			return padded[i:]
+		}
+	}
+
+	// An all-zero value trims down to a single zero digit.
+	if len(padded) > 0 {
+		return string(a.encode[:1])
+	}
+	return ""
+}
@@ -0,0 +1,306 @@
+package base32
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var testAlphabets = map[string]*Alphabet{
+	"Crockford": Crockford,
+	"RFC4648":   RFC4648,
+	"Base32Hex": Base32Hex,
+	"ZBase32":   ZBase32,
+	"Geohash":   Geohash,
+}
+
+func TestNewAlphabet(t *testing.T) {
+	invalid := []struct {
+		digits  string
+		aliases map[byte]byte
+	}{
+		{"0123456789", nil},
+		{"0123456789ABCDEFGHJKMNPQRSTVWXYZ0", nil},
+		{"0123456789ABCDEFGHJKMNPQRSTVWXY0", nil}, // Duplicate digit.
+		{"0123456789ABCDEFGHJKMNPQRSTVWXY-", nil}, // Hyphen.
+		{"0123456789ABCDEFGHJKMNPQRSTVWXY ", nil}, // Space.
+		{"0123456789ABCDEFGHJKMNPQRSTVWXYZ", map[byte]byte{'A': '0'}},
+		{"0123456789ABCDEFGHJKMNPQRSTVWXYZ", map[byte]byte{'O': 'U'}},
+		{"0123456789ABCDEFGHJKMNPQRSTVWXYZ", map[byte]byte{'-': '0'}},
+	}
+
+	for _, c := range invalid {
+		_, err := NewAlphabet(c.digits, c.aliases)
+		if err == nil {
+			t.Errorf("Expected NewAlphabet(%q, %v) to return an error, got nil.",
+				c.digits, c.aliases)
+		}
+	}
+
+	// An alphabet that uses both cases of a letter is case sensitive for
+	// that letter.
+	mixed := MustAlphabet("0123456789ABCDEFGHJKMNPQRSTVWXZz", nil)
+	for input, expected := range map[string]uint32{"Z": 30, "z": 31, "x": 29} {
+		if val, err := mixed.Decode(input); val != expected || err != nil {
+			t.Errorf("Expected mixed-case Decode(%q) to be %d, <nil>; got %d, %v.",
+				input, expected, val, err)
+		}
+	}
+}
+
+func TestCrockfordTables(t *testing.T) {
+	if expected := "0123456789ABCDEFGHJKMNPQRSTVWXYZ*~$=U"; string(encodingValue[:]) != expected {
+		t.Errorf("Expected encodingValue to be %q, got %q.", expected, encodingValue[:])
+	}
+
+	for char := 0; char < 256; char++ {
+		expected := uint32(invalidDecodeValue)
+		if val := Crockford.decode[char]; val != invalidAlphabetValue {
+			expected = uint32(val)
+		}
+		if actual := digitValue(byte(char)); actual != expected {
+			t.Errorf("Expected digitValue(%q) to be %d, got %d.", char, expected, actual)
+		}
+	}
+
+	for input, expected := range map[byte]uint32{'0': 0, 'o': 0, 'O': 0, 'i': 1, 'L': 1, 'v': 27, 'Z': 31, 'U': invalidDecodeValue, '-': invalidDecodeValue} {
+		if actual := digitValue(input); actual != expected {
+			t.Errorf("Expected digitValue(%q) to be %d, got %d.", input, expected, actual)
+		}
+	}
+}
+
+// The package-level functions have their own fast paths, so make sure they
+// still agree with the Crockford alphabet.
+func TestCrockfordFunctions(t *testing.T) {
+	const chars = "0123456789ABCDEFGHJKMNPQRSTVWXYZoOiIlLabxyzU-*"
+	for i := 0; i < 100000; i++ {
+		var input = make([]byte, 1+rand.Intn(8))
+		for j := range input {
+			input[j] = chars[rand.Intn(len(chars))]
+		}
+
+		// Base32.Decode checks the length before the digits, and doesn't allow
+		// leading zeros past 7 digits, so only compare values that fit.
+		expected, expectedErr := Crockford.Decode(string(input))
+		if actual, err := Base32(input).Decode(); len(input) <= 7 && ((err == nil) != (expectedErr == nil) || (err == nil && actual != expected)) {
+			t.Fatalf("Expected Decode(%q) to be %d, %v; got %d, %v.", input, expected, expectedErr, actual, err)
+		}
+
+		normalized, normalizedErr := Crockford.FromString(string(input))
+		if actual, err := FromString(string(input)); string(actual) != normalized || err != normalizedErr {
+			t.Fatalf("Expected FromString(%q) to be %q, %v; got %q, %v.", input, normalized, normalizedErr, actual, err)
+		}
+
+		if normalizedErr == nil {
+			if actual, expected := Trim(string(input)), Crockford.Trim(string(input)); string(actual) != expected {
+				t.Fatalf("Expected Trim(%q) to be %q, got %q.", input, expected, actual)
+			}
+		}
+	}
+}
+
+func TestAlphabet_Encode(t *testing.T) {
+	cases := []struct {
+		alphabet *Alphabet
+		input    uint32
+		expected string
+	}{
+		{Crockford, 0, "0"},
+		{Crockford, 90, "2T"},
+		{Crockford, maxUint32Value, "3ZZZZZZ"},
+		{RFC4648, 0, "A"},
+		{RFC4648, 90, "C2"},
+		{Base32Hex, 90, "2Q"},
+		{ZBase32, 90, "n4"},
+		{Geohash, 90, "2u"},
+	}
+
+	for _, c := range cases {
+		actual := c.alphabet.Encode(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected Encode(%d) to be %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+
+	// The Crockford alphabet must match the package-level functions.
+	for i := 0; i < 10000; i++ {
+		input := rand.Uint32()
+		if Crockford.Encode(input) != string(Encode(input)) {
+			t.Fatalf("Expected Crockford.Encode(%d) to be %q, got %q.",
+				input, Encode(input), Crockford.Encode(input))
+		}
+	}
+}
+
+func TestAlphabet_Decode(t *testing.T) {
+	valid := []struct {
+		alphabet *Alphabet
+		input    string
+		expected uint32
+	}{
+		{Crockford, "2t", 90},
+		{Crockford, "oL", 1},
+		{Crockford, "003ZZZZZZ", maxUint32Value},
+		{RFC4648, "c2", 90},
+		{Base32Hex, "2q", 90},
+		{ZBase32, "N4", 90},
+		{Geohash, "2U", 90},
+	}
+
+	for _, c := range valid {
+		actual, err := c.alphabet.Decode(c.input)
+		if err != nil || actual != c.expected {
+			t.Errorf("Expected Decode(%q) to be %d, <nil>; got %d, %v.",
+				c.input, c.expected, actual, err)
+		}
+	}
+
+	invalid := []struct {
+		alphabet *Alphabet
+		input    string
+		err      error
+	}{
+		{Crockford, "", decodeEmptyString},
+		{Crockford, "U", decodeInvalidDigit},
+		{Crockford, "2-T", decodeInvalidDigit},
+		{Crockford, "4000000", decodeTooBig32},
+		{RFC4648, "01", decodeInvalidDigit},
+		{Geohash, "a", decodeInvalidDigit},
+	}
+
+	for _, c := range invalid {
+		_, err := c.alphabet.Decode(c.input)
+		if err != c.err {
+			t.Errorf("Expected Decode(%q) to return error %v, got %v.", c.input, c.err, err)
+		}
+	}
+
+	for name, alphabet := range testAlphabets {
+		for i := 0; i < 10000; i++ {
+			input := rand.Uint32()
+			encoded := alphabet.Encode(input)
+			decoded, err := alphabet.Decode(encoded)
+			if err != nil || decoded != input {
+				t.Fatalf("Expected %s.Decode(%q) to be %d, <nil>; got %d, %v.",
+					name, encoded, input, decoded, err)
+			}
+		}
+	}
+}
+
+func TestAlphabet_FromString(t *testing.T) {
+	valid := []struct {
+		alphabet *Alphabet
+		input    string
+		expected string
+	}{
+		{Crockford, "0", "0"},
+		{Crockford, "o", "0"},
+		{Crockford, "AAA-bbb-o-l", "AAABBB01"},
+		{Crockford, "00-Example-00", "EXAMP1E00"},
+		{RFC4648, "aa-c2", "C2"},
+		{RFC4648, "AAA", "A"},
+		{ZBase32, "YYN4", "n4"},
+	}
+
+	for _, c := range valid {
+		actual, err := c.alphabet.FromString(c.input)
+		if err != nil || actual != c.expected {
+			t.Errorf("Expected FromString(%q) to be %q, <nil>; got %q, %v.",
+				c.input, c.expected, actual, err)
+		}
+	}
+
+	invalid := []struct {
+		alphabet *Alphabet
+		input    string
+	}{
+		{Crockford, ""},
+		{Crockford, "-"},
+		{Crockford, "---"},
+		{RFC4648, "-"},
+		{Crockford, "CUT"},
+		{Crockford, "a b"},
+		{RFC4648, "018"},
+	}
+
+	for _, c := range invalid {
+		_, err := c.alphabet.FromString(c.input)
+		if err == nil {
+			t.Errorf("Expected FromString(%q) to return an error, got nil.", c.input)
+		}
+	}
+}
+
+func TestAlphabet_PadTrim(t *testing.T) {
+	cases := []struct {
+		alphabet *Alphabet
+		input    string
+		padded   string
+	}{
+		{Crockford, "Z", "0000Z"},
+		{Crockford, "ABCDEF", "ABCDEF"},
+		{RFC4648, "C2", "AAAC2"},
+		{ZBase32, "n4", "yyyn4"},
+	}
+
+	for _, c := range cases {
+		padded := string(c.alphabet.Pad(c.input, 5))
+		if padded != c.padded {
+			t.Errorf("Expected Pad(%q, 5) to be %q, got %q.", c.input, c.padded, padded)
+		}
+		trimmed := c.alphabet.Trim(padded)
+		if trimmed != c.input {
+			t.Errorf("Expected Trim(%q) to be %q, got %q.", padded, c.input, trimmed)
+		}
+	}
+
+	trimCases := []struct {
+		alphabet *Alphabet
+		input    string
+		expected string
+	}{
+		{Crockford, "00-oo-00TEST", "TEST"},
+		{Crockford, "000", "0"},
+		{RFC4648, "aA-AB", "B"},
+		{Crockford, "", ""},
+	}
+
+	for _, c := range trimCases {
+		actual := c.alphabet.Trim(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected Trim(%q) to be %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func BenchmarkAlphabet_Encode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ZBase32.Encode(123123123)
+	}
+}
+
+func BenchmarkAlphabet_Decode(b *testing.B) {
+	base32 := "N0NoN0"
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Crockford.Decode(base32)
+	}
+}
+
+func ExampleAlphabet() {
+	fmt.Println(Crockford.Encode(90))
+	fmt.Println(ZBase32.Encode(90))
+	fmt.Println(Base32Hex.Encode(90))
+
+	decimal, _ := Geohash.Decode("2u")
+	fmt.Println(decimal)
+	// Output:
+	// 2T
+	// n4
+	// 2Q
+	// 90
+}
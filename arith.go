@@ -0,0 +1,147 @@
+package base32
+
+// Add returns the sum of `num` and `other`. It works directly on the digits, so
+// there is no limit on the length of either number, and no overflow.
+//
+// Like Compare, leading zeros are ignored and the common input errors are
+// handled like Decode does. The result is normalized, with no leading zeros.
+// InvalidBase32Value is returned if either number is invalid.
+func (num Base32) Add(other Base32) Base32 {
+	a, b := digitValues(num), digitValues(other)
+	if a == nil || b == nil {
+		return InvalidBase32Value
+	}
+
+	// Make `a` the longer number.
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	var result = make([]byte, len(a)+1)
+	var carry byte
+	for i := 1; i <= len(a); i++ {
+		sum := a[len(a)-i] + carry
+		if i <= len(b) {
+			sum += b[len(b)-i]
+		}
+		result[len(result)-i] = sum & 31
+		carry = sum >> 5
+	}
+	result[0] = carry
+
+	return fromDigitValues(result)
+}
+
+// Sub returns `num` minus `other`. See Add. InvalidBase32Value is returned if
+// either number is invalid or if `other` is bigger than `num`, since Base32
+// numbers can't be negative.
+func (num Base32) Sub(other Base32) Base32 {
+	a, b := digitValues(num), digitValues(other)
+	if a == nil || b == nil {
+		return InvalidBase32Value
+	}
+
+	var result = make([]byte, len(a))
+	var borrow byte
+	for i := 1; i <= len(a); i++ {
+		sub := borrow
+		if i <= len(b) {
+			sub += b[len(b)-i]
+		}
+		digit := a[len(a)-i]
+		if digit < sub {
+			digit += 32
+			borrow = 1
+		} else {
+			borrow = 0
+		}
+		result[len(result)-i] = digit - sub
+	}
+
+	// Anything left over in `other`, or a borrow out of the top digit, means
+	// the result is negative.
+	for i := len(a) + 1; i <= len(b); i++ {
+		if b[len(b)-i] != 0 {
+			return InvalidBase32Value
+		}
+	}
+	if borrow != 0 {
+		return InvalidBase32Value
+	}
+
+	return fromDigitValues(result)
+}
+
+// Cmp is the same as Compare. It is named to match the math/big package.
+func (num Base32) Cmp(other Base32) int {
+	return num.Compare(other)
+}
+
+// MulSmall returns `num` times `m`. See Add.
+func (num Base32) MulSmall(m uint32) Base32 {
+	a := digitValues(num)
+	if a == nil {
+		return InvalidBase32Value
+	}
+
+	// A uint32 is at most 7 Base32 digits, so that's the most the result
+	// can grow by.
+	var result = make([]byte, len(a)+SortableWidth32)
+	var carry uint64
+	for i := 1; i <= len(result); i++ {
+		product := carry
+		if i <= len(a) {
+			product += uint64(a[len(a)-i]) * uint64(m)
+		}
+		result[len(result)-i] = byte(product & 31)
+		carry = product >> 5
+	}
+
+	return fromDigitValues(result)
+}
+
+// DivModSmall returns `num` divided by `d`, and the remainder. See Add.
+// InvalidBase32Value and 0 are returned if `num` is invalid or `d` is zero.
+func (num Base32) DivModSmall(d uint32) (Base32, uint32) {
+	a := digitValues(num)
+	if a == nil || d == 0 {
+		return InvalidBase32Value, 0
+	}
+
+	// Long division, most significant digit first.
+	var result = make([]byte, len(a))
+	var remainder uint64
+	for i, digit := range a {
+		remainder = remainder<<5 | uint64(digit)
+		result[i] = byte(remainder / uint64(d))
+		remainder %= uint64(d)
+	}
+
+	return fromDigitValues(result), uint32(remainder)
+}
+
+// digitValues returns the value of each digit of `num`, or nil if `num` is
+// empty or has an invalid digit.
+func digitValues(num Base32) []byte {
+	if len(num) == 0 {
+		return nil
+	}
+	var values = make([]byte, len(num))
+	for i := 0; i < len(num); i++ {
+		val := digitValue(num[i])
+		if val == invalidDecodeValue {
+			return nil
+		}
+		values[i] = byte(val)
+	}
+	return values
+}
+
+// fromDigitValues turns digit values back into a Base32 number with no
+// leading zeros. It reuses the `values` slice.
+func fromDigitValues(values []byte) Base32 {
+	for i, val := range values {
+		values[i] = encodingValue[val]
+	}
+	return trimDigits(values)
+}
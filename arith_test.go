@@ -0,0 +1,207 @@
+package base32
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// The digits math/big uses for base 32, in order of value.
+const bigDigits = "0123456789abcdefghijklmnopqrstuv"
+
+// toBig converts a Base32 number into a big.Int, the slow way.
+func toBig(num Base32) *big.Int {
+	var translated = make([]byte, len(num))
+	for i := 0; i < len(num); i++ {
+		translated[i] = bigDigits[digitValue(num[i])]
+	}
+	result, _ := new(big.Int).SetString(string(translated), 32)
+	return result
+}
+
+// fromBig converts a big.Int into a Base32 number, the slow way.
+func fromBig(n *big.Int) Base32 {
+	text := []byte(n.Text(32))
+	for i, char := range text {
+		text[i] = encodingValue[strings.IndexByte(bigDigits, char)]
+	}
+	return Base32(text)
+}
+
+// randomBase32 returns a random Base32 number with up to `maxDigits` digits.
+func randomBase32(maxDigits int) Base32 {
+	var digits = make([]byte, rand.Intn(maxDigits)+1)
+	for i := range digits {
+		digits[i] = encodingValue[rand.Intn(32)]
+	}
+	return Base32(digits)
+}
+
+func TestBase32_Add(t *testing.T) {
+	cases := []struct {
+		a, b, expected Base32
+	}{
+		{"0", "0", "0"},
+		{"Z", "1", "10"},
+		{"ZZZZZZZZZZZZZZZZZZZZ", "1", "100000000000000000000"},
+		{"00z", "o1", "10"},
+		{"U", "1", InvalidBase32Value},
+		{"", "1", InvalidBase32Value},
+	}
+
+	for _, c := range cases {
+		if actual := c.a.Add(c.b); actual != c.expected {
+			t.Errorf("Expected %q.Add(%q) to be %q, got %q.", c.a, c.b, c.expected, actual)
+		}
+	}
+
+	for i := 0; i < 10000; i++ {
+		a, b := randomBase32(40), randomBase32(40)
+		expected := fromBig(new(big.Int).Add(toBig(a), toBig(b)))
+		if actual := a.Add(b); actual != expected {
+			t.Fatalf("Expected %q.Add(%q) to be %q, got %q.", a, b, expected, actual)
+		}
+	}
+}
+
+func TestBase32_Sub(t *testing.T) {
+	cases := []struct {
+		a, b, expected Base32
+	}{
+		{"10", "1", "Z"},
+		{"100000000000000000000", "1", "ZZZZZZZZZZZZZZZZZZZZ"},
+		{"2T", "2T", "0"},
+		{"2T", "002S", "1"},
+		{"1", "2", InvalidBase32Value},
+		{"1", "10", InvalidBase32Value},
+		{"U", "1", InvalidBase32Value},
+	}
+
+	for _, c := range cases {
+		if actual := c.a.Sub(c.b); actual != c.expected {
+			t.Errorf("Expected %q.Sub(%q) to be %q, got %q.", c.a, c.b, c.expected, actual)
+		}
+	}
+
+	for i := 0; i < 10000; i++ {
+		a, b := randomBase32(40), randomBase32(40)
+		if a.Cmp(b) < 0 {
+			a, b = b, a
+		}
+		expected := fromBig(new(big.Int).Sub(toBig(a), toBig(b)))
+		if actual := a.Sub(b); actual != expected {
+			t.Fatalf("Expected %q.Sub(%q) to be %q, got %q.", a, b, expected, actual)
+		}
+	}
+}
+
+func TestBase32_MulDivModSmall(t *testing.T) {
+	cases := []struct {
+		a        Base32
+		m        uint32
+		expected Base32
+	}{
+		{"2T", 0, "0"},
+		{"2T", 1, "2T"},
+		{"Z", 32, "Z0"},
+		{"3ZZZZZZ", maxUint32Value, "FZZZZZR000001"},
+		{"U", 1, InvalidBase32Value},
+	}
+
+	for _, c := range cases {
+		if actual := c.a.MulSmall(c.m); actual != c.expected {
+			t.Errorf("Expected %q.MulSmall(%d) to be %q, got %q.", c.a, c.m, c.expected, actual)
+		}
+	}
+
+	if q, r := Base32("2T").DivModSmall(0); q != InvalidBase32Value || r != 0 {
+		t.Errorf("Expected DivModSmall(0) to be %q, 0; got %q, %d.", InvalidBase32Value, q, r)
+	}
+
+	for i := 0; i < 10000; i++ {
+		a := randomBase32(40)
+		m := rand.Uint32() >> uint(rand.Intn(32))
+
+		expected := fromBig(new(big.Int).Mul(toBig(a), big.NewInt(int64(m))))
+		if actual := a.MulSmall(m); actual != expected {
+			t.Fatalf("Expected %q.MulSmall(%d) to be %q, got %q.", a, m, expected, actual)
+		}
+
+		if m == 0 {
+			continue
+		}
+		bigQ, bigR := new(big.Int).DivMod(toBig(a), big.NewInt(int64(m)), new(big.Int))
+		q, r := a.DivModSmall(m)
+		if q != fromBig(bigQ) || uint64(r) != bigR.Uint64() {
+			t.Fatalf("Expected %q.DivModSmall(%d) to be %q, %d; got %q, %d.",
+				a, m, fromBig(bigQ), bigR.Uint64(), q, r)
+		}
+	}
+}
+
+var benchmarkA, benchmarkB = Base32("3ZZZZZZABCDEFGHJKMNPQRSTVWXYZ"), Base32("ZZZZZZZZZZZZ")
+
+// BenchmarkBase32_Add          7332061         169   ns/op        32 B/op        1 allocs/op # Go 1.27, 29 + 12 digits
+func BenchmarkBase32_Add(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = benchmarkA.Add(benchmarkB)
+	}
+}
+
+// BenchmarkBigInt_Add           900193        1934   ns/op       368 B/op       12 allocs/op # Go 1.27, big.Int round-trip
+func BenchmarkBigInt_Add(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = fromBig(new(big.Int).Add(toBig(benchmarkA), toBig(benchmarkB)))
+	}
+}
+
+// BenchmarkBase32_MulSmall     3467851         320   ns/op        96 B/op        2 allocs/op # Go 1.27
+func BenchmarkBase32_MulSmall(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = benchmarkA.MulSmall(123123123)
+	}
+}
+
+// BenchmarkBigInt_MulSmall     1000000        1138   ns/op       408 B/op       10 allocs/op # Go 1.27, big.Int round-trip
+func BenchmarkBigInt_MulSmall(b *testing.B) {
+	b.ReportAllocs()
+	m := big.NewInt(123123123)
+	for i := 0; i < b.N; i++ {
+		_ = fromBig(new(big.Int).Mul(toBig(benchmarkA), m))
+	}
+}
+
+// BenchmarkBase32_DivModSmall  4041963         296   ns/op        24 B/op        1 allocs/op # Go 1.27
+func BenchmarkBase32_DivModSmall(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = benchmarkA.DivModSmall(123123123)
+	}
+}
+
+// BenchmarkBigInt_DivModSmall  1253230        1244   ns/op       272 B/op        9 allocs/op # Go 1.27, big.Int round-trip
+func BenchmarkBigInt_DivModSmall(b *testing.B) {
+	b.ReportAllocs()
+	d := big.NewInt(123123123)
+	for i := 0; i < b.N; i++ {
+		q, _ := new(big.Int).DivMod(toBig(benchmarkA), d, new(big.Int))
+		_ = fromBig(q)
+	}
+}
+
+func ExampleBase32_Add() {
+	fmt.Println(Base32("ZZZZZZZZZZZZZZZZZZZZ").Add("1"))
+	fmt.Println(Base32("10").Sub("1"))
+	fmt.Println(Base32("Z").MulSmall(32))
+	fmt.Println(Base32("2T").DivModSmall(7))
+	// Output:
+	// 100000000000000000000
+	// Z
+	// Z0
+	// C 6
+}
@@ -134,9 +134,8 @@ func Encode(num uint32) Base32 {
 // though, and will return an error.
 //
 // Performance note: This function is very fast for already-valid Base32 Values,
-// and for totally invalid values. 0 memory allocations. Only when the input is
-// technically valid but totally non-normalized does this method get crazy (~2
-// allocations).
+// and for totally invalid values. 0 memory allocations. Input that needs to be
+// normalized is handed to Crockford.FromString (1 allocation).
 //
 func FromString(base32String string) (Base32, error) {
 
@@ -163,85 +162,12 @@ func FromString(base32String string) (Base32, error) {
 		return Base32(base32String), nil
 	}
 
-	// Check for invalid characters.
-	for _, rune := range base32String {
-
-		isNumber := rune >= '0' && rune <= '9'
-		isUpper := rune >= 'A' && rune <= 'Z' && rune != 'U'
-		isLower := rune >= 'a' && rune <= 'z' && rune != 'u'
-		isHyphen := rune == '-'
-
-		isValid := isNumber || isUpper || isLower || isHyphen
-
-		if !isValid {
-			return InvalidBase32Value, decodeInvalidDigit
-		}
-	}
-
-	// Find the first non-zero character so we can trim off any zero padding.
-	firstNonZeroCharIndex := 0
-	for i, char := range base32String {
-		isZero := char == '0' || char == 'o' || char == 'O'
-		isHyphen := char == '-'
-		if !isZero && !isHyphen {
-			firstNonZeroCharIndex = i
-			break
-		}
-	}
-
-	// Count all hyphens in the string that occur AFTER the first non-zero
-	// character. These will have to be deleted later on.
-	interiorHyphenCount := 0
-	for i := firstNonZeroCharIndex + 1; i < inputLength; i++ {
-		if base32String[i] == '-' {
-			interiorHyphenCount++
-		}
+	// Otherwise, normalize it the long way.
+	normalized, err := Crockford.FromString(base32String)
+	if err != nil {
+		return InvalidBase32Value, err
 	}
-
-	// Mutate the characters in the result string into normalized digits. For
-	// example, convert lowercase letters into uppercase, etc.
-
-	var lenResult = inputLength - firstNonZeroCharIndex - interiorHyphenCount
-	var result = make([]byte, lenResult)
-	var inputIndex = firstNonZeroCharIndex
-	var destIndex = 0
-
-	for inputIndex < inputLength {
-
-		char := base32String[inputIndex]
-		inputIndex++
-
-		// Convert letter O to numeral 0.
-		if char == 'o' || char == 'O' {
-			result[destIndex] = '0'
-			destIndex++
-			continue
-		}
-
-		// Convert letters L and I into numeral 1.
-		if char == 'l' || char == 'L' || char == 'i' || char == 'I' {
-			result[destIndex] = '1'
-			destIndex++
-			continue
-		}
-
-		// Uppercase the characters, ASCII hack.
-		if char >= 'a' && char <= 'z' {
-			result[destIndex] = char - 32
-			destIndex++
-			continue
-		}
-
-		if char == '-' {
-			// Skip hyphen.
-			continue
-		}
-
-		result[destIndex] = char
-		destIndex++
-	}
-
-	return Base32(result), nil
+	return Base32(normalized), nil
 }
 
 var (
@@ -357,27 +283,7 @@ func (check Check) String() string {
 //
 // The input value must be valid or the result of this method is undefined.
 func (num Base32) Pad(n uint8) []byte {
-	finalWidth := int(n)
-	inputLength := len(num)
-
-	// If we're already at least n characters wide, nothing to do here.
-	if inputLength >= finalWidth {
-		return []byte(num)
-	}
-
-	// start is where the base32 digits start in the result's byte slice.
-	var start = int(finalWidth - inputLength)
-	var result = make([]byte, finalWidth)
-
-	for i := 0; i < finalWidth; i++ {
-		if i < start {
-			result[i] = '0'
-		} else {
-			result[i] = num[i-start]
-		}
-	}
-
-	return result
+	return Crockford.Pad(string(num), n)
 }
 
 // Trim removes zeros from the beginning of the argument and returns the
@@ -387,17 +293,9 @@ func (num Base32) Pad(n uint8) []byte {
 //
 // The input value must be an otherwise valid Base32 value, or else the result
 // of this function is undefined. (This function does treat the letters
-// 'o' and 'O' and the hyphen as zeros.)
+// 'o' and 'O' and the hyphen as zeros.) An all-zero value trims down to "0".
 func Trim(padded string) Base32 {
-	firstNonZeroIdx := 0
-	for i, char := range padded {
-		var isZero = char == '0' || char == 'o' || char == 'O' || char == '-'
-		if !isZero {
-			firstNonZeroIdx = i
-			break
-		}
-	}
-	return Base32(padded[firstNonZeroIdx:])
+	return Base32(Crockford.Trim(padded))
 }
 
 // WillFit returns true if the Base32 value can be decoded into a uint32
@@ -424,18 +322,35 @@ func (num Base32) WillFit() bool {
 	}
 
 	// A 7-digit Base32 value will fit if the most significant digit is 3 or
-	// under.
-	var msd = num[0]
-	return msd == '3' || msd == '2' || msd == '1' || msd == '0'
+	// under. Aliases like 'O' and 'l' count as the digits they stand for.
+	return digitValue(num[0]) <= 3
 }
 
 // GenerateCheck returns the checksum byte for a given argument. It will be one
 // of 0-9, the valid Base32 values of A-Z, or *, ~, $, =, or U.
 func GenerateCheck(num uint32) Check {
-	const checksumPrime = 37
+	return checkOf64(uint64(num))
+}
+
+// The check symbol is the value modulo checksumPrime, written with
+// encodingValue.
+const checksumPrime = 37
+
+// checkOf64 is GenerateCheck for a uint64.
+func checkOf64(num uint64) Check {
 	return Check(encodingValue[num%checksumPrime])
 }
 
+// checkOf returns the check symbol for a Base32 number of any length.
+func checkOf(num Base32) Check {
+	if num.WillFit() {
+		decoded, _ := num.Decode()
+		return GenerateCheck(decoded)
+	}
+	_, remainder := num.DivModSmall(checksumPrime)
+	return Check(encodingValue[remainder])
+}
+
 var (
 	invalidCheckLength = errors.New("A check string must be exactly 1 byte long")
 	invalidCheckDigit  = errors.New("The input value is not a valid checksum digit")
@@ -463,7 +378,7 @@ func CheckFromString(input string) (result Check, err error) {
 	}
 
 	char := rune(input[0])
-	validBase32Digit := validBase32Digit[char]
+	validBase32Digit := Crockford.decode[input[0]] != invalidAlphabetValue
 	validChecksumDigit := char == '*' || char == '~' || char == '$' || char == '=' || char == 'u' || char == 'U'
 
 	if !validBase32Digit && !validChecksumDigit {
@@ -485,188 +400,39 @@ func CheckFromString(input string) (result Check, err error) {
 	return Check(char), nil
 }
 
-var encodingValue = [...]byte{
-	'0',
-	'1',
-	'2',
-	'3',
-	'4',
-	'5',
-	'6',
-	'7',
-	'8',
-	'9',
-	'A',
-	'B',
-	'C',
-	'D',
-	'E',
-	'F',
-	'G',
-	'H',
-	'J',
-	'K',
-	'M',
-	'N',
-	'P',
-	'Q',
-	'R',
-	'S',
-	'T',
-	'V',
-	'W',
-	'X',
-	'Y',
-	'Z',
-	'*', // ONLY USED FOR CHECKSUM
-	'~', // ONLY USED FOR CHECKSUM
-	'$', // ONLY USED FOR CHECKSUM
-	'=', // ONLY USED FOR CHECKSUM
-	'U', // ONLY USED FOR CHECKSUM
-}
+// The check symbols that only GenerateCheck uses, for the values 32 to 36.
+const checkSymbols = "*~$=U"
 
-// Todo: Delete this map and remove uses of it.
-var validBase32Digit = map[rune]bool{
-	'0': true,
-	'1': true,
-	'2': true,
-	'3': true,
-	'4': true,
-	'5': true,
-	'6': true,
-	'7': true,
-	'8': true,
-	'9': true,
-	'A': true,
-	'B': true,
-	'C': true,
-	'D': true,
-	'E': true,
-	'F': true,
-	'G': true,
-	'H': true,
-	'I': true,
-	'J': true,
-	'K': true,
-	'L': true,
-	'M': true,
-	'N': true,
-	'O': true,
-	'P': true,
-	'Q': true,
-	'R': true,
-	'S': true,
-	'T': true,
-	'V': true,
-	'W': true,
-	'X': true,
-	'Y': true,
-	'Z': true,
-	'a': true,
-	'b': true,
-	'c': true,
-	'd': true,
-	'e': true,
-	'f': true,
-	'g': true,
-	'h': true,
-	'i': true,
-	'j': true,
-	'k': true,
-	'l': true,
-	'm': true,
-	'n': true,
-	'o': true,
-	'p': true,
-	'q': true,
-	'r': true,
-	's': true,
-	't': true,
-	'v': true,
-	'w': true,
-	'x': true,
-	'y': true,
-	'z': true,
-}
+// encodingValue maps a value to its digit in the Crockford alphabet, followed
+// by the check symbols. It is built from Crockford so that the package-level
+// functions and the Crockford Alphabet can't disagree.
+var encodingValue = func() (table [32 + len(checkSymbols)]byte) {
+	copy(table[:], Crockford.encode[:])
+	copy(table[32:], checkSymbols)
+	return table
+}()
 
 const decodeMaxRune = 'z'
 const decodeMinRune = '0'
 const invalidDecodeValue = 99 // 31 is the maximum valid value
 
-var decodingValue = [...]uint32{
-	'0': 0, // 48 = 0x30
-	'1': 1,
-	'2': 2,
-	'3': 3,
-	'4': 4,
-	'5': 5,
-	'6': 6,
-	'7': 7,
-	'8': 8,
-	'9': 9, // 57 = 0x39
-	58:  invalidDecodeValue,
-	59:  invalidDecodeValue,
-	60:  invalidDecodeValue,
-	61:  invalidDecodeValue,
-	62:  invalidDecodeValue,
-	63:  invalidDecodeValue,
-	64:  invalidDecodeValue,
-	'A': 10, // 65 = 0x40
-	'B': 11,
-	'C': 12,
-	'D': 13,
-	'E': 14,
-	'F': 15,
-	'G': 16,
-	'H': 17,
-	'I': 1,
-	'J': 18,
-	'K': 19,
-	'L': 1,
-	'M': 20,
-	'N': 21,
-	'O': 0,
-	'P': 22,
-	'Q': 23,
-	'R': 24,
-	'S': 25,
-	'T': 26,
-	'U': invalidDecodeValue,
-	'V': 27,
-	'W': 28,
-	'X': 29,
-	'Y': 30,
-	'Z': 31, // 90 = 0x5A
-	91:  invalidDecodeValue,
-	92:  invalidDecodeValue,
-	93:  invalidDecodeValue,
-	94:  invalidDecodeValue,
-	95:  invalidDecodeValue,
-	96:  invalidDecodeValue,
-	'a': 10, // 97 = 0x61
-	'b': 11,
-	'c': 12,
-	'd': 13,
-	'e': 14,
-	'f': 15,
-	'g': 16,
-	'h': 17,
-	'i': 1,
-	'j': 18,
-	'k': 19,
-	'l': 1,
-	'm': 20,
-	'n': 21,
-	'o': 0,
-	'p': 22,
-	'q': 23,
-	'r': 24,
-	's': 25,
-	't': 26,
-	'u': invalidDecodeValue,
-	'v': 27,
-	'w': 28,
-	'x': 29,
-	'y': 30,
-	'z': 31,
+// decodingValue maps a digit or alias in the Crockford alphabet to its value,
+// or to invalidDecodeValue. Like encodingValue, it is built from Crockford.
+var decodingValue = func() (table [decodeMaxRune + 1]uint32) {
+	for i := range table {
+		table[i] = invalidDecodeValue
+		if val := Crockford.decode[i]; val != invalidAlphabetValue {
+			table[i] = uint32(val)
+		}
+	}
+	return table
+}()
+
+// digitValue returns the value of a single Base32 digit, or invalidDecodeValue.
+func digitValue(digit byte) uint32 {
+	// Same two-part validity check as Base32.Decode.
+	if rune(digit) > decodeMaxRune || rune(digit) < decodeMinRune {
+		return invalidDecodeValue
+	}
+	return decodingValue[digit]
 }
@@ -66,6 +66,8 @@ func TestFromString(t *testing.T) {
 	var invalid = [...]string{
 		"CUT", // U is an invalid character
 		"",    // Empty string is an invalid Base32 value.
+		"-",   // So is a string of only hyphens.
+		"---",
 		"a*b", // * is an invalid character
 		"a b", // space is an invalid character
 	}
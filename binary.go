@@ -0,0 +1,101 @@
+package base32
+
+import (
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+)
+
+var decodeBinaryMalformed = errors.New("Malformed binary Base32 value")
+
+func init() {
+	// So that Base32 values can be sent in interface-typed gob fields.
+	gob.Register(Base32(""))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, which is also what the
+// encoding/gob package uses.
+//
+// The binary form is the number of digits as a uvarint, followed by the
+// digits packed 5 bits each, most significant first, with the last byte padded
+// on the right with zero bits. Every digit is kept, including leading zeros,
+// so "002T" and "2T" marshal differently. Letters are case insensitive, and
+// 'O', 'I' and 'L' are read as '0', '1' and '1', like Decode reads them.
+//
+// An n-digit value takes 1 + ceil(n * 5 / 8) bytes (for up to 127 digits),
+// which is smaller than the text for values of 6 digits or more. The
+// InvalidBase32Value marshals to a single zero byte.
+//
+// An error is returned if the value has an invalid digit.
+func (num Base32) MarshalBinary() ([]byte, error) {
+	var result = make([]byte, 0, binary.MaxVarintLen64+(len(num)*5+7)/8)
+	result = binary.AppendUvarint(result, uint64(len(num)))
+
+	var buffer uint32 // Bits waiting to be written, right-aligned.
+	var bits uint     // Number of bits in buffer.
+
+	for i := 0; i < len(num); i++ {
+		val := digitValue(num[i])
+		if val == invalidDecodeValue {
+			return nil, decodeInvalidDigit
+		}
+
+		buffer = buffer<<5 | val
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			result = append(result, byte(buffer>>bits))
+		}
+	}
+
+	if bits > 0 {
+		result = append(result, byte(buffer<<(8-bits)))
+	}
+
+	return result, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It is the opposite of
+// MarshalBinary, and always produces normalized digits, so marshaling the
+// result again gives back the same bytes.
+//
+// An error is returned if `data` isn't exactly the length given by its header,
+// or if the padding bits in the last byte aren't zero.
+func (num *Base32) UnmarshalBinary(data []byte) error {
+	const fiveOnes = 31 // Binary 11111
+
+	digits, headerLength := binary.Uvarint(data)
+	if headerLength <= 0 {
+		return decodeBinaryMalformed
+	}
+	data = data[headerLength:]
+
+	// Checked this way around so that a huge digit count can't overflow.
+	if digits > uint64(len(data))*8/5 || uint64(len(data)) != (digits*5+7)/8 {
+		return decodeBinaryMalformed
+	}
+
+	var result = make([]byte, digits)
+	var buffer uint32 // Bits waiting to be read, right-aligned.
+	var bits uint     // Number of bits in buffer.
+	var destIndex = 0
+
+	for _, b := range data {
+		buffer = buffer<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 && destIndex < len(result) {
+			bits -= 5
+			result[destIndex] = encodingValue[buffer>>bits&fiveOnes]
+			destIndex++
+		}
+	}
+
+	// Non-zero padding bits would mean two different byte strings unmarshal
+	// to the same value.
+	if buffer&(1<<bits-1) != 0 {
+		return decodeBinaryMalformed
+	}
+
+	*num = Base32(result)
+	return nil
+}
@@ -0,0 +1,142 @@
+package base32
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBase32_MarshalBinary(t *testing.T) {
+	cases := []struct {
+		input    Base32
+		expected []byte
+	}{
+		{InvalidBase32Value, []byte{0}},
+		{"0", []byte{1, 0x00}},
+		{"Z", []byte{1, 0xF8}},
+		{"2T", []byte{2, 0x16, 0x80}},
+		{"2t", []byte{2, 0x16, 0x80}},
+		{"002T", []byte{4, 0x00, 0x05, 0xA0}},
+		{"3ZZZZZZ", []byte{7, 0x1F, 0xFF, 0xFF, 0xFF, 0xE0}},
+		{"ZZZZZZZZ", []byte{8, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}},
+	}
+	for _, c := range cases {
+		actual, err := c.input.MarshalBinary()
+		if err != nil {
+			t.Errorf("Expected MarshalBinary(%q) to succeed, got %v.", c.input, err)
+		}
+		if !bytes.Equal(actual, c.expected) {
+			t.Errorf("Expected MarshalBinary(%q) to be %x, got %x.", c.input, c.expected, actual)
+		}
+	}
+
+	for _, invalid := range []Base32{"2U", "2-T", "2*"} {
+		if _, err := invalid.MarshalBinary(); err != decodeInvalidDigit {
+			t.Errorf("Expected MarshalBinary(%q) to fail, got %v.", invalid, err)
+		}
+	}
+}
+
+func TestBase32_UnmarshalBinary(t *testing.T) {
+	cases := []struct {
+		input    []byte
+		expected Base32
+		err      error
+	}{
+		{[]byte{0}, InvalidBase32Value, nil},
+		{[]byte{2, 0x16, 0x80}, "2T", nil},
+		{[]byte{4, 0x00, 0x05, 0xA0}, "002T", nil},
+		{nil, InvalidBase32Value, decodeBinaryMalformed},
+		{[]byte{0x80}, InvalidBase32Value, decodeBinaryMalformed},
+		{[]byte{2, 0x16}, InvalidBase32Value, decodeBinaryMalformed},
+		{[]byte{2, 0x16, 0x80, 0x00}, InvalidBase32Value, decodeBinaryMalformed},
+		{[]byte{2, 0x16, 0x81}, InvalidBase32Value, decodeBinaryMalformed},
+		{[]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01, 0x00}, InvalidBase32Value, decodeBinaryMalformed},
+	}
+	for _, c := range cases {
+		var actual Base32
+		err := actual.UnmarshalBinary(c.input)
+		if err != c.err {
+			t.Errorf("Expected UnmarshalBinary(%x) to return error %v, got %v.", c.input, c.err, err)
+		}
+		if actual != c.expected {
+			t.Errorf("Expected UnmarshalBinary(%x) to be %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestBase32_MarshalBinary_RoundTrip(t *testing.T) {
+	for length := 1; length <= 200; length++ {
+		var num = Base32(strings.Repeat("0Z8G", 50)[:length])
+
+		data, err := num.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Expected MarshalBinary(%q) to succeed, got %v.", num, err)
+		}
+		if length >= 6 && len(data) >= length {
+			t.Errorf("Expected MarshalBinary(%q) to be shorter than the text, got %d bytes.", num, len(data))
+		}
+
+		var decoded Base32
+		if err := decoded.UnmarshalBinary(data); err != nil || decoded != num {
+			t.Errorf("Expected UnmarshalBinary to give back %q, got %q (%v).", num, decoded, err)
+		}
+
+		again, _ := decoded.MarshalBinary()
+		if !bytes.Equal(again, data) {
+			t.Errorf("Expected MarshalBinary(%q) to be stable, got %x then %x.", num, data, again)
+		}
+	}
+}
+
+func TestBase32_Gob(t *testing.T) {
+	type message struct {
+		ID    Base32
+		Other interface{}
+	}
+
+	var buffer bytes.Buffer
+	var sent = message{ID: "002T", Other: Base32("1A2B3C")}
+	if err := gob.NewEncoder(&buffer).Encode(sent); err != nil {
+		t.Fatalf("Expected gob encoding to succeed, got %v.", err)
+	}
+
+	var received message
+	if err := gob.NewDecoder(&buffer).Decode(&received); err != nil {
+		t.Fatalf("Expected gob decoding to succeed, got %v.", err)
+	}
+	if received != sent {
+		t.Errorf("Expected gob to round trip %+v, got %+v.", sent, received)
+	}
+}
+
+func BenchmarkBase32_MarshalBinary(b *testing.B) {
+	b.ReportAllocs()
+	num := Base32("1A2B3C4D5E6F7")
+	for i := 0; i < b.N; i++ {
+		num.MarshalBinary()
+	}
+}
+
+func BenchmarkBase32_UnmarshalBinary(b *testing.B) {
+	b.ReportAllocs()
+	data, _ := Base32("1A2B3C4D5E6F7").MarshalBinary()
+	var num Base32
+	for i := 0; i < b.N; i++ {
+		num.UnmarshalBinary(data)
+	}
+}
+
+func ExampleBase32_MarshalBinary() {
+	data, _ := Base32("1A2B3C4D").MarshalBinary()
+	fmt.Printf("%x\n", data)
+
+	var num Base32
+	num.UnmarshalBinary(data)
+	fmt.Println(num)
+	// Output:
+	// 080a84b1b08d
+	// 1A2B3C4D
+}
@@ -0,0 +1,99 @@
+package base32
+
+import (
+	"errors"
+)
+
+var decodeBitsLength = errors.New("Wrong number of Base32 digits for the expected number of bytes")
+
+// encodedLen returns the number of Base32 digits needed to hold `n` bytes.
+func encodedLen(n int) int {
+	return (n*8 + 4) / 5
+}
+
+// EncodeBytes renders `src` as Base32 digits, 5 bits per digit, most
+// significant bit first. If the number of bits isn't a multiple of 5, the last
+// digit is padded on the right with zero bits.
+//
+// Unlike Encode, leading zeros are kept, so the result is always
+// ceil(len(src) * 8 / 5) digits long. Unlike the encoding/base32 package in the
+// Go standard library, the result uses the Crockford alphabet and is never
+// padded with '=' characters.
+func EncodeBytes(src []byte) string {
+	const fiveOnes = 31 // Binary 11111
+
+	var result = make([]byte, encodedLen(len(src)))
+	var buffer uint32 // Bits waiting to be encoded, right-aligned.
+	var bits uint     // Number of bits in buffer.
+	var destIndex = 0
+
+	for _, b := range src {
+		buffer = buffer<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			result[destIndex] = encodingValue[buffer>>bits&fiveOnes]
+			destIndex++
+		}
+	}
+
+	if bits > 0 {
+		result[destIndex] = encodingValue[buffer<<(5-bits)&fiveOnes]
+	}
+
+	return string(result)
+}
+
+// DecodeBytes is the opposite of EncodeBytes. It decodes the Base32 digits in
+// `input` into exactly `n` bytes.
+//
+// The input is cleaned up the same way FromString does it: letters are case
+// insensitive, 'O' is read as '0', 'I' and 'L' are read as '1', and hyphens
+// are ignored. Leading zeros are significant, though. An error is returned if
+// the input has an invalid digit, or doesn't have exactly ceil(n * 8 / 5)
+// digits, or if the padding bits in the last digit aren't zero.
+func DecodeBytes(input string, n int) ([]byte, error) {
+
+	var result = make([]byte, n)
+	var buffer uint32 // Bits waiting to be decoded, right-aligned.
+	var bits uint     // Number of bits in buffer.
+	var destIndex = 0
+	var digits = 0
+
+	for i := 0; i < len(input); i++ {
+		rn := input[i]
+		if rn == '-' {
+			continue
+		}
+
+		val := digitValue(rn)
+		if val == invalidDecodeValue {
+			return nil, decodeInvalidDigit
+		}
+
+		digits++
+		if digits > encodedLen(n) {
+			return nil, decodeBitsLength
+		}
+
+		buffer = buffer<<5 | val
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			result[destIndex] = byte(buffer >> bits)
+			destIndex++
+		}
+	}
+
+	if digits != encodedLen(n) {
+		return nil, decodeBitsLength
+	}
+
+	// Non-zero padding bits would mean two different strings decode to the
+	// same bytes.
+	if buffer&(1<<bits-1) != 0 {
+		return nil, decodeInvalidDigit
+	}
+
+	return result, nil
+}
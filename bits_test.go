@@ -0,0 +1,89 @@
+package base32
+
+import (
+	"bytes"
+	crypto "crypto/rand"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeBytes(t *testing.T) {
+	cases := []struct {
+		input    []byte
+		expected string
+	}{
+		{[]byte{}, ""},
+		{[]byte{0x00}, "00"},
+		{[]byte{0xff}, "ZW"},
+		{[]byte{0x00, 0x00, 0x00, 0x00, 0x5A}, "0000002T"},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff}, "ZZZZZZZZ"},
+	}
+
+	for _, c := range cases {
+		actual := EncodeBytes(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected EncodeBytes(%x) to be %q, got %q.",
+				c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestDecodeBytes(t *testing.T) {
+	valid := []struct {
+		input    string
+		n        int
+		expected []byte
+	}{
+		{"", 0, []byte{}},
+		{"zw", 1, []byte{0xff}},
+		{"oooo-oo2t", 5, []byte{0x00, 0x00, 0x00, 0x00, 0x5A}},
+	}
+
+	for _, c := range valid {
+		actual, err := DecodeBytes(c.input, c.n)
+		if err != nil || !bytes.Equal(actual, c.expected) {
+			t.Errorf("Expected DecodeBytes(%q, %d) to be %x, <nil>; got %x, %v.",
+				c.input, c.n, c.expected, actual, err)
+		}
+	}
+
+	invalid := []struct {
+		input string
+		n     int
+		err   error
+	}{
+		{"Z", 1, decodeBitsLength},
+		{"ZWZ", 1, decodeBitsLength},
+		{"ZU", 1, decodeInvalidDigit},
+		{"Z*", 1, decodeInvalidDigit},
+		{"ZZ", 1, decodeInvalidDigit}, // Non-zero padding bits.
+	}
+
+	for _, c := range invalid {
+		_, err := DecodeBytes(c.input, c.n)
+		if err != c.err {
+			t.Errorf("Expected DecodeBytes(%q, %d) to return error %v, got %v.",
+				c.input, c.n, c.err, err)
+		}
+	}
+}
+
+func TestEncodeDecodeBytes(t *testing.T) {
+	const n = 10000
+
+	for i := 0; i < n; i++ {
+		input := make([]byte, rand.Intn(30))
+		if _, err := io.ReadFull(crypto.Reader, input); err != nil {
+			panic("Unable to generate random bytes.")
+		}
+
+		encoded := EncodeBytes(input)
+		decoded, err := DecodeBytes(encoded, len(input))
+		if err != nil {
+			t.Errorf("Expected DecodeBytes(%q) to succeed, got error %q.", encoded, err)
+		} else if !bytes.Equal(decoded, input) {
+			t.Errorf("Expected DecodeBytes(%q) to be %x, got %x.", encoded, input, decoded)
+		}
+	}
+}
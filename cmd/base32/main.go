@@ -0,0 +1,146 @@
+// Command base32 is a small command-line companion to the base32 package.
+//
+// Usage:
+//
+//	base32 <command> [flags]
+//
+// The commands are:
+//
+//	entropy    work out how many digits randomly generated codes need
+//
+// Run "base32 <command> -h" for the flags of each command.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	base32 "github.com/Dancapistan/gobase32"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command in `args` and returns the exit status.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "usage: base32 <command> [flags]\n\ncommands:\n  entropy")
+		return 2
+	}
+
+	switch args[0] {
+	case "entropy":
+		return entropy(args[1:], stdout, stderr)
+	}
+
+	fmt.Fprintf(stderr, "base32: unknown command %q\n", args[0])
+	return 2
+}
+
+// entropy prints the entropy, collision and guessing odds for random codes,
+// or the fewest digits that meet a target probability.
+func entropy(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("entropy", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: base32 entropy [flags]")
+		fmt.Fprintln(stderr, "\nPrints the entropy of random codes by length, with the chance of a collision")
+		fmt.Fprintln(stderr, "among -items codes and of guessing one of -valid codes at -rate guesses per")
+		fmt.Fprintln(stderr, "-per for -window. With -p, prints the fewest digits that keep each chance at")
+		fmt.Fprintln(stderr, "or below -p instead.\n\nflags:")
+		fs.PrintDefaults()
+	}
+
+	var (
+		digits = fs.Int("digits", 0, "number of random `digits`; 0 shows 4 to 16")
+		check  = fs.Bool("check", false, "codes end with a check symbol")
+		group  = fs.Int("group", 0, "hyphenate every `n` characters")
+		items  = fs.Uint64("items", 0, "number of codes generated, for collisions")
+		valid  = fs.Uint64("valid", 0, "number of codes valid at once, for guessing (default -items)")
+		rate   = fs.Uint64("rate", 0, "guesses allowed per -per, for guessing")
+		per    = fs.Duration("per", time.Minute, "rate limit `period`")
+		window = fs.Duration("window", 30*24*time.Hour, "how long codes stay valid")
+		target = fs.Float64("p", 0, "target `probability`")
+	)
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() > 0 || *digits < 0 || *group < 0 || *per <= 0 || *target < 0 || *target >= 1 {
+		fs.Usage()
+		return 2
+	}
+
+	if *valid == 0 {
+		*valid = *items
+	}
+	var guesses uint64
+	if *rate > 0 {
+		guesses = *rate * uint64(*window / *per)
+	}
+
+	format := func(digits int) base32.CodeFormat {
+		return base32.CodeFormat{Digits: digits, Check: *check, GroupSize: *group}
+	}
+
+	if *target > 0 {
+		if *items == 0 && guesses == 0 {
+			fmt.Fprintln(stderr, "base32: -p needs -items, or -rate with -valid or -items")
+			return 2
+		}
+		if *items > 0 {
+			printMinimum(stdout, "collision", base32.MinDigitsForCollision(*items, *target), format)
+		}
+		if guesses > 0 {
+			printMinimum(stdout, "guessing", base32.MinDigitsForGuessing(*valid, guesses, *target), format)
+		}
+		return 0
+	}
+
+	var first, last = 4, 16
+	if *digits > 0 {
+		first, last = *digits, *digits
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "DIGITS\tLENGTH\tFORMAT\tBITS")
+	if *items > 0 {
+		fmt.Fprint(w, "\tCOLLISION")
+	}
+	if guesses > 0 {
+		fmt.Fprint(w, "\tGUESSING")
+	}
+	fmt.Fprintln(w)
+
+	for d := first; d <= last; d++ {
+		f := format(d)
+		fmt.Fprintf(w, "%d\t%d\t%s\t%g", d, f.Len(), f, f.Bits())
+		if *items > 0 {
+			fmt.Fprintf(w, "\t%.3g", base32.CollisionProbability(*items, d))
+		}
+		if guesses > 0 {
+			fmt.Fprintf(w, "\t%.3g", base32.GuessProbability(d, *valid, guesses))
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+
+	return 0
+}
+
+// printMinimum prints the result of one of the MinDigits functions.
+func printMinimum(w io.Writer, name string, digits int, format func(int) base32.CodeFormat) {
+	if digits == 0 {
+		fmt.Fprintf(w, "%s: more than 100 digits needed\n", name)
+		return
+	}
+	f := format(digits)
+	fmt.Fprintf(w, "%s: %d digits, %g bits, %d characters (%s)\n", name, digits, f.Bits(), f.Len(), f)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun_Entropy(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{
+			[]string{"entropy", "-digits", "8", "-check", "-group", "4"},
+			"DIGITS  LENGTH  FORMAT       BITS\n" +
+				"8       11      XXXX-XXXX-C  40\n",
+		},
+		{
+			[]string{"entropy", "-digits", "10", "-items", "1000000", "-rate", "10"},
+			"DIGITS  LENGTH  FORMAT      BITS  COLLISION  GUESSING\n" +
+				"10      10      XXXXXXXXXX  50    0.000444   0.000384\n",
+		},
+		{
+			[]string{"entropy", "-items", "1000000", "-p", "1e-6", "-check", "-group", "4"},
+			"collision: 12 digits, 60 bits, 16 characters (XXXX-XXXX-XXXX-C)\n",
+		},
+		{
+			[]string{"entropy", "-valid", "100000", "-rate", "10", "-window", "720h", "-p", "0.001"},
+			"guessing: 10 digits, 50 bits, 10 characters (XXXXXXXXXX)\n",
+		},
+	}
+	for _, c := range cases {
+		var stdout, stderr bytes.Buffer
+		status := run(c.args, &stdout, &stderr)
+		if status != 0 {
+			t.Errorf("Expected %q to succeed, got status %d: %s", c.args, status, stderr.String())
+		}
+		if stdout.String() != c.expected {
+			t.Errorf("Expected %q to print:\n%s\ngot:\n%s", c.args, c.expected, stdout.String())
+		}
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	cases := [][]string{
+		nil,
+		{"nope"},
+		{"entropy", "-p", "0.5"},
+		{"entropy", "-p", "2", "-items", "10"},
+		{"entropy", "-digits", "-1"},
+		{"entropy", "extra"},
+		{"entropy", "-bogus"},
+	}
+	for _, args := range cases {
+		var stdout, stderr bytes.Buffer
+		if status := run(args, &stdout, &stderr); status != 2 {
+			t.Errorf("Expected %q to fail with status 2, got %d.", args, status)
+		}
+		if !strings.Contains(stderr.String(), "base32") {
+			t.Errorf("Expected %q to explain the error, got %q.", args, stderr.String())
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package base32
+
+// A Code is a Base32 number that fits in a uint64, stored in a fixed-size
+// array instead of a string, so that encoding and decoding don't allocate.
+//
+// Codes are always normalized (no leading zeros, uppercase letters, no
+// aliases), so two Codes are equal with == exactly when they have the same
+// value. That also makes them usable as map keys.
+//
+// The zero Code is invalid, like InvalidBase32Value. Use EncodeCode or
+// CodeFromString to make a Code, and Base32 or String to convert it when a
+// string is needed.
+type Code struct {
+	digits [SortableWidth64]byte // Right-aligned, with zero bytes before them.
+	length uint8
+}
+
+// EncodeCode is like Encode, but for a uint64, and returns a Code.
+//
+// Performance note: 0 memory allocations.
+func EncodeCode(num uint64) Code {
+	const fiveOnes uint64 = 31 // Binary 11111
+
+	var code Code
+	var i = len(code.digits)
+	for {
+		i--
+		code.digits[i] = encodingValue[num&fiveOnes]
+		num >>= 5
+		if num == 0 {
+			break
+		}
+	}
+	code.length = uint8(len(code.digits) - i)
+
+	return code
+}
+
+// CodeFromString is like FromString, but returns a Code. It normalizes the
+// digits the same way, and trims leading zeros. An error is returned if the
+// input is empty, has an invalid digit, or is too big for a uint64.
+//
+// Performance note: 0 memory allocations.
+func CodeFromString(base32String string) (Code, error) {
+	value, consumed, digits, err := decodeDigits64(base32String, true)
+	if err != nil {
+		return Code{}, err
+	}
+	if consumed != len(base32String) {
+		return Code{}, decodeInvalidDigit
+	}
+	if digits == 0 {
+		return Code{}, decodeEmptyString
+	}
+
+	return EncodeCode(value), nil
+}
+
+// Decode returns the value of the Code. An error is returned only for the
+// zero Code.
+//
+// Performance note: 0 memory allocations.
+func (c Code) Decode() (uint64, error) {
+	if c.length == 0 {
+		return 0, decodeEmptyString
+	}
+
+	var result uint64
+	for _, digit := range c.digits[len(c.digits)-int(c.length):] {
+		result = result<<5 | uint64(decodingValue[digit])
+	}
+	return result, nil
+}
+
+// Len returns the number of digits in the Code, which is 0 for the zero Code.
+func (c Code) Len() int {
+	return int(c.length)
+}
+
+// Base32 returns the Code as a Base32 value, or InvalidBase32Value for the zero
+// Code.
+//
+// Performance note: 1 memory allocation.
+func (c Code) Base32() Base32 {
+	return Base32(c.digits[len(c.digits)-int(c.length):])
+}
+
+// String returns the digits of the Code, or "<invalid>" for the zero Code, like
+// Base32.String.
+func (c Code) String() string {
+	return c.Base32().String()
+}
+
+// AppendTo appends the digits of the Code to `dst` and returns the extended
+// buffer. Nothing is appended for the zero Code.
+func (c Code) AppendTo(dst []byte) []byte {
+	return append(dst, c.digits[len(c.digits)-int(c.length):]...)
+}
+
+// MarshalText implements encoding.TextMarshaler. The zero Code marshals to
+// empty text.
+func (c Code) MarshalText() ([]byte, error) {
+	return c.AppendTo(nil), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, using CodeFromString.
+func (c *Code) UnmarshalText(text []byte) error {
+	code, err := CodeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
@@ -0,0 +1,161 @@
+package base32
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestEncodeCode(t *testing.T) {
+	cases := []struct {
+		input    uint64
+		expected string
+	}{
+		{0, "0"},
+		{31, "Z"},
+		{90, "2T"},
+		{uint64(maxUint32Value), "3ZZZZZZ"},
+		{maxUint64Value, "FZZZZZZZZZZZZ"},
+	}
+	for _, c := range cases {
+		code := EncodeCode(c.input)
+		if actual := code.String(); actual != c.expected {
+			t.Errorf("Expected EncodeCode(%d) to be %q, got %q.", c.input, c.expected, actual)
+		}
+		if code.Len() != len(c.expected) {
+			t.Errorf("Expected EncodeCode(%d).Len() to be %d, got %d.", c.input, len(c.expected), code.Len())
+		}
+		if decoded, err := code.Decode(); err != nil || decoded != c.input {
+			t.Errorf("Expected EncodeCode(%d).Decode() to round trip, got %d (%v).", c.input, decoded, err)
+		}
+		if fromEncode := Base32(c.expected); c.input <= uint64(maxUint32Value) && Encode(uint32(c.input)) != fromEncode {
+			t.Errorf("Expected EncodeCode(%d) to match Encode.", c.input)
+		}
+	}
+}
+
+func TestCodeFromString(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"2T", "2T", nil},
+		{"00-2t", "2T", nil},
+		{"oIl", "11", nil},
+		{"0000", "0", nil},
+		{"FZZZZZZZZZZZZ", "FZZZZZZZZZZZZ", nil},
+		{"000FZZZZZZZZZZZZ", "FZZZZZZZZZZZZ", nil},
+		{"G000000000000", "<invalid>", decodeTooBig64},
+		{"", "<invalid>", decodeEmptyString},
+		{"--", "<invalid>", decodeEmptyString},
+		{"2U", "<invalid>", decodeInvalidDigit},
+	}
+	for _, c := range cases {
+		code, err := CodeFromString(c.input)
+		if err != c.err {
+			t.Errorf("Expected CodeFromString(%q) to return error %v, got %v.", c.input, c.err, err)
+		}
+		if actual := code.String(); actual != c.expected {
+			t.Errorf("Expected CodeFromString(%q) to be %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestCode_Comparable(t *testing.T) {
+	a, _ := CodeFromString("00-2t")
+	b := EncodeCode(90)
+	if a != b {
+		t.Errorf("Expected %v and %v to be equal.", a, b)
+	}
+
+	var seen = map[Code]int{a: 1}
+	if seen[b] != 1 {
+		t.Errorf("Expected %v to be found in the map.", b)
+	}
+	if EncodeCode(91) == b {
+		t.Errorf("Expected different values to give different Codes.")
+	}
+}
+
+func TestCode_Zero(t *testing.T) {
+	var code Code
+	if _, err := code.Decode(); err != decodeEmptyString {
+		t.Errorf("Expected the zero Code to fail to decode, got %v.", err)
+	}
+	if code.Base32() != InvalidBase32Value || code.String() != "<invalid>" || code.Len() != 0 {
+		t.Errorf("Expected the zero Code to be invalid, got %q.", code.Base32())
+	}
+	if actual := string(code.AppendTo([]byte("x"))); actual != "x" {
+		t.Errorf("Expected AppendTo to append nothing, got %q.", actual)
+	}
+}
+
+func TestCode_Text(t *testing.T) {
+	type message struct {
+		ID Code
+	}
+
+	data, err := json.Marshal(message{ID: EncodeCode(90)})
+	if err != nil || string(data) != `{"ID":"2T"}` {
+		t.Errorf("Expected json.Marshal to give %s, got %s (%v).", `{"ID":"2T"}`, data, err)
+	}
+
+	var decoded message
+	if err := json.Unmarshal([]byte(`{"ID":"1a-2b"}`), &decoded); err != nil || decoded.ID.String() != "1A2B" {
+		t.Errorf("Expected json.Unmarshal to give 1A2B, got %v (%v).", decoded.ID, err)
+	}
+	if err := json.Unmarshal([]byte(`{"ID":"2U"}`), &decoded); err == nil {
+		t.Errorf("Expected json.Unmarshal to reject an invalid digit.")
+	}
+}
+
+func TestCode_Allocations(t *testing.T) {
+	var buffer = make([]byte, 0, 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		code := EncodeCode(1234567890123)
+		code.Decode()
+		code, _ = CodeFromString("1-2345-6789")
+		buffer = code.AppendTo(buffer[:0])
+	})
+	if allocs != 0 {
+		t.Errorf("Expected Code functions not to allocate, got %v allocations.", allocs)
+	}
+}
+
+// BenchmarkEncodeCode  193351365           6.50 ns/op         0 B/op        0 allocs/op # Go 1.27
+func BenchmarkEncodeCode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		EncodeCode(uint64(i))
+	}
+}
+
+// BenchmarkCodeFromString  24976696          45.8 ns/op         0 B/op        0 allocs/op # Go 1.27
+func BenchmarkCodeFromString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		CodeFromString("1A2B3C4D5E")
+	}
+}
+
+// BenchmarkCode_Decode 100000000          11.0 ns/op         0 B/op        0 allocs/op # Go 1.27
+func BenchmarkCode_Decode(b *testing.B) {
+	b.ReportAllocs()
+	code := EncodeCode(1234567890123)
+	for i := 0; i < b.N; i++ {
+		code.Decode()
+	}
+}
+
+func ExampleCode() {
+	var counts = make(map[Code]int)
+	for _, input := range []string{"2T", "02t", "ABC", "0-2-T"} {
+		code, _ := CodeFromString(input)
+		counts[code]++
+	}
+
+	two, _ := CodeFromString("2T")
+	fmt.Println(counts[two], len(counts))
+	// Output: 3 2
+}
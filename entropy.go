@@ -0,0 +1,129 @@
+package base32
+
+import (
+	"math"
+	"strings"
+)
+
+// The longest code the MinDigits functions will suggest, which is 500 bits.
+const maxEntropyDigits = 100
+
+// A CodeFormat describes how randomly generated codes, such as promo codes,
+// are written, so that the cost of a check symbol and hyphens can be weighed
+// against the entropy of the digits.
+type CodeFormat struct {
+	// Digits is the number of random Base32 digits.
+	Digits int
+
+	// Check says whether a check symbol follows the digits. A check symbol
+	// catches typos, but adds no entropy, since anyone can compute it.
+	Check bool
+
+	// GroupSize is the number of characters between hyphens, counting the
+	// check symbol, like 4 for "ABCD-EFGH-J". Zero means no hyphens.
+	GroupSize int
+}
+
+// Len returns the number of characters in a code, including the check symbol
+// and hyphens.
+func (f CodeFormat) Len() int {
+	var symbols = f.Digits
+	if f.Check {
+		symbols++
+	}
+	if f.GroupSize <= 0 || symbols == 0 {
+		return symbols
+	}
+	return symbols + (symbols-1)/f.GroupSize
+}
+
+// Bits returns the entropy of a code in bits. See EntropyBits.
+func (f CodeFormat) Bits() float64 {
+	return EntropyBits(f.Digits)
+}
+
+// BitsPerChar returns the entropy of a code divided by its length, which shows
+// how much the check symbol and hyphens cost. The best possible value is 5.
+func (f CodeFormat) BitsPerChar() float64 {
+	if f.Len() == 0 {
+		return 0
+	}
+	return f.Bits() / float64(f.Len())
+}
+
+// String returns a pattern for the format, with X for each digit and C for the
+// check symbol, like "XXXX-XXXX-C".
+func (f CodeFormat) String() string {
+	var symbols = strings.Repeat("X", f.Digits)
+	if f.Check {
+		symbols += "C"
+	}
+
+	var result = make([]byte, 0, f.Len())
+	for i := 0; i < len(symbols); i++ {
+		if f.GroupSize > 0 && i > 0 && i%f.GroupSize == 0 {
+			result = append(result, '-')
+		}
+		result = append(result, symbols[i])
+	}
+	return string(result)
+}
+
+// EntropyBits returns the entropy, in bits, of `digits` random Base32 digits,
+// which is 5 bits per digit.
+func EntropyBits(digits int) float64 {
+	return float64(5 * digits)
+}
+
+// MinDigitsForCollision returns the fewest random digits that keep the chance
+// of any two of `items` codes being the same at or below `probability`. See
+// CollisionProbability.
+//
+// It returns 0 if `probability` isn't greater than zero, or if more than 100
+// digits would be needed.
+func MinDigitsForCollision(items uint64, probability float64) int {
+	return minEntropyDigits(probability, func(digits int) float64 {
+		return CollisionProbability(items, digits)
+	})
+}
+
+// GuessProbability returns the chance that at least one of `guesses` random
+// guesses hits one of `validCodes` codes of `digits` random digits. Under a
+// rate limit, `guesses` is the number of guesses allowed while the codes are
+// valid, like 10 per minute for 30 days.
+func GuessProbability(digits int, validCodes, guesses uint64) float64 {
+	if validCodes == 0 || guesses == 0 || digits < 0 {
+		return 0
+	}
+	hit := float64(validCodes) / math.Exp2(EntropyBits(digits))
+	if hit >= 1 {
+		return 1
+	}
+	return -math.Expm1(float64(guesses) * math.Log1p(-hit))
+}
+
+// MinDigitsForGuessing returns the fewest random digits that keep the chance
+// of `guesses` guesses hitting any of `validCodes` codes at or below
+// `probability`. See GuessProbability.
+//
+// It returns 0 if `probability` isn't greater than zero, or if more than 100
+// digits would be needed.
+func MinDigitsForGuessing(validCodes, guesses uint64, probability float64) int {
+	return minEntropyDigits(probability, func(digits int) float64 {
+		return GuessProbability(digits, validCodes, guesses)
+	})
+}
+
+// minEntropyDigits returns the smallest number of digits, from 1, for which
+// `chance` is at or below `probability`, or 0.
+func minEntropyDigits(probability float64, chance func(digits int) float64) int {
+	if !(probability > 0) {
+		return 0
+	}
+	for digits := 1; digits <= maxEntropyDigits; digits++ {
+		if chance(digits) <= probability {
+			return digits
+		}
+	}
+	return 0
+}
@@ -0,0 +1,115 @@
+package base32
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestCodeFormat(t *testing.T) {
+	cases := []struct {
+		format      CodeFormat
+		length      int
+		pattern     string
+		bitsPerChar float64
+	}{
+		{CodeFormat{}, 0, "", 0},
+		{CodeFormat{Digits: 8}, 8, "XXXXXXXX", 5},
+		{CodeFormat{Digits: 8, Check: true}, 9, "XXXXXXXXC", 40.0 / 9},
+		{CodeFormat{Digits: 8, GroupSize: 4}, 9, "XXXX-XXXX", 40.0 / 9},
+		{CodeFormat{Digits: 8, Check: true, GroupSize: 4}, 11, "XXXX-XXXX-C", 40.0 / 11},
+		{CodeFormat{Digits: 7, Check: true, GroupSize: 4}, 9, "XXXX-XXXC", 35.0 / 9},
+		{CodeFormat{Digits: 20, GroupSize: 5}, 23, "XXXXX-XXXXX-XXXXX-XXXXX", 100.0 / 23},
+	}
+	for _, c := range cases {
+		if actual := c.format.Len(); actual != c.length {
+			t.Errorf("Expected %+v.Len() to be %d, got %d.", c.format, c.length, actual)
+		}
+		if actual := c.format.String(); actual != c.pattern {
+			t.Errorf("Expected %+v.String() to be %q, got %q.", c.format, c.pattern, actual)
+		}
+		if actual := c.format.BitsPerChar(); math.Abs(actual-c.bitsPerChar) > 1e-9 {
+			t.Errorf("Expected %+v.BitsPerChar() to be %g, got %g.", c.format, c.bitsPerChar, actual)
+		}
+		if actual := c.format.Bits(); actual != EntropyBits(c.format.Digits) {
+			t.Errorf("Expected %+v.Bits() to be %g, got %g.", c.format, EntropyBits(c.format.Digits), actual)
+		}
+	}
+}
+
+func TestMinDigitsForCollision(t *testing.T) {
+	cases := []struct {
+		items       uint64
+		probability float64
+		expected    int
+	}{
+		{1000000, 0.01, 10},
+		{1000000, 1e-6, 12},
+		{10000, 0.5, 6},
+		{1, 0.01, 1},
+		{1000000, 0, 0},
+		{1000000, math.NaN(), 0},
+		{math.MaxUint64, 1e-300, 0},
+	}
+	for _, c := range cases {
+		actual := MinDigitsForCollision(c.items, c.probability)
+		if actual != c.expected {
+			t.Errorf("Expected MinDigitsForCollision(%d, %g) to be %d, got %d.", c.items, c.probability, c.expected, actual)
+		}
+		if actual > 1 && CollisionProbability(c.items, actual-1) <= c.probability {
+			t.Errorf("Expected %d digits not to be enough for %d items.", actual-1, c.items)
+		}
+	}
+}
+
+func TestGuessProbability(t *testing.T) {
+	cases := []struct {
+		digits   int
+		valid    uint64
+		guesses  uint64
+		expected float64
+	}{
+		{8, 0, 1000, 0},
+		{8, 1000, 0, 0},
+		{2, 1, 1, 1.0 / 1024},
+		{8, 100000, 432000, 0.03853},
+		{1, 32, 1, 1},
+		{1, 100, 1, 1},
+	}
+	for _, c := range cases {
+		actual := GuessProbability(c.digits, c.valid, c.guesses)
+		if math.Abs(actual-c.expected) > c.expected*0.001 {
+			t.Errorf("Expected GuessProbability(%d, %d, %d) to be %g, got %g.", c.digits, c.valid, c.guesses, c.expected, actual)
+		}
+	}
+}
+
+func TestMinDigitsForGuessing(t *testing.T) {
+	cases := []struct {
+		valid       uint64
+		guesses     uint64
+		probability float64
+		expected    int
+	}{
+		{100000, 10 * 60 * 24 * 30, 1e-3, 10},
+		{1000, 1000000, 0.5, 7},
+		{0, 1000000, 0.5, 1},
+		{1000, 1000000, -1, 0},
+	}
+	for _, c := range cases {
+		actual := MinDigitsForGuessing(c.valid, c.guesses, c.probability)
+		if actual != c.expected {
+			t.Errorf("Expected MinDigitsForGuessing(%d, %d, %g) to be %d, got %d.", c.valid, c.guesses, c.probability, c.expected, actual)
+		}
+	}
+}
+
+func ExampleMinDigitsForCollision() {
+	// A million promo codes, with at most a 1 in a million chance of any
+	// duplicates.
+	digits := MinDigitsForCollision(1000000, 1e-6)
+
+	format := CodeFormat{Digits: digits, Check: true, GroupSize: 4}
+	fmt.Println(format, format.Len(), format.Bits())
+	// Output: XXXX-XXXX-XXXX-C 16 60
+}
@@ -0,0 +1,167 @@
+package base32
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	checkMissing = errors.New("Missing check symbol")
+	checkNoMatch = errors.New("The check symbol does not match the value")
+)
+
+// An InvalidDigitError is returned by Base32Flag, Uint64Flag and ParseID when
+// the input has a character that isn't a Base32 digit, alias or hyphen.
+//
+// It wraps the same error that FromString returns for invalid digits, so
+// errors.Is works on either.
+type InvalidDigitError struct {
+	Input  string
+	Offset int // Byte offset of the invalid character in Input.
+}
+
+func (e *InvalidDigitError) Error() string {
+	return fmt.Sprintf("Invalid Base32 digit %q at offset %d in %q", e.Input[e.Offset], e.Offset, e.Input)
+}
+
+func (e *InvalidDigitError) Unwrap() error {
+	return decodeInvalidDigit
+}
+
+// A Base32Flag is a flag.Value for a Base32 number of any length:
+//
+//	var id base32.Base32Flag
+//	flag.Var(&id, "id", "the order `ID`")
+//
+// Set normalizes its input with FromString, so "00-2t" is read as "2T". If
+// Check is true, the input must end with a check symbol that matches the
+// value, and String writes the check symbol after the value.
+//
+// Base32Flag also implements encoding.TextMarshaler and
+// encoding.TextUnmarshaler, so it can be used with flag.TextVar.
+type Base32Flag struct {
+	Value Base32
+	Check bool
+}
+
+// String returns the value, followed by its check symbol if Check is true.
+// It returns the empty string if the value hasn't been set.
+func (f *Base32Flag) String() string {
+	if f == nil || f.Value == InvalidBase32Value {
+		return ""
+	}
+	if f.Check {
+		return string(f.Value) + string(checkOf(f.Value))
+	}
+	return string(f.Value)
+}
+
+// Set implements flag.Value. An *InvalidDigitError is returned if the input
+// has an invalid digit.
+func (f *Base32Flag) Set(input string) error {
+	digits, check, err := splitCheck(input, f.Check)
+	if err != nil {
+		return err
+	}
+
+	value, err := FromString(digits)
+	if err != nil {
+		return err
+	}
+
+	if f.Check && !checkMatches(value, check) {
+		return checkNoMatch
+	}
+
+	f.Value = value
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It is the same as String.
+func (f *Base32Flag) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It is the same as Set.
+func (f *Base32Flag) UnmarshalText(text []byte) error {
+	return f.Set(string(text))
+}
+
+// A Uint64Flag is a flag.Value for a number written in Base32, which must fit
+// in a uint64. It behaves like Base32Flag, but stores the decoded number.
+type Uint64Flag struct {
+	Value uint64
+	Check bool
+}
+
+// String returns the value in Base32, followed by its check symbol if Check is
+// true.
+func (f *Uint64Flag) String() string {
+	if f == nil {
+		return ""
+	}
+	if f.Check {
+		return EncodeCode(f.Value).String() + string(checkOf64(f.Value))
+	}
+	return EncodeCode(f.Value).String()
+}
+
+// Set implements flag.Value. An *InvalidDigitError is returned if the input
+// has an invalid digit, and an error is returned if the value is too big for
+// a uint64.
+func (f *Uint64Flag) Set(input string) error {
+	var b32 = Base32Flag{Check: f.Check}
+	if err := b32.Set(input); err != nil {
+		return err
+	}
+
+	value, consumed, err := ParsePrefix(string(b32.Value))
+	if err != nil {
+		return err
+	}
+	if consumed != len(b32.Value) {
+		return decodeInvalidDigit
+	}
+
+	f.Value = value
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. It is the same as String.
+func (f *Uint64Flag) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It is the same as Set.
+func (f *Uint64Flag) UnmarshalText(text []byte) error {
+	return f.Set(string(text))
+}
+
+// splitCheck splits user input, such as a flag's value, into its digits and,
+// if `withCheck` is true, its normalized check symbol.
+func splitCheck(input string, withCheck bool) (digits string, check Check, err error) {
+	if len(input) == 0 {
+		return "", InvalidCheckValue, decodeEmptyString
+	}
+
+	digits, check = input, InvalidCheckValue
+	if withCheck {
+		if len(input) < 2 || input[len(input)-2] == '-' {
+			return "", InvalidCheckValue, checkMissing
+		}
+		check, err = CheckFromString(input[len(input)-1:])
+		if err != nil {
+			return "", InvalidCheckValue, err
+		}
+		digits = input[:len(input)-1]
+	}
+
+	for i := 0; i < len(digits); i++ {
+		char := digits[i]
+		if char != '-' && digitValue(char) == invalidDecodeValue {
+			return "", InvalidCheckValue, &InvalidDigitError{Input: input, Offset: i}
+		}
+	}
+
+	return digits, check, nil
+}
@@ -0,0 +1,164 @@
+package base32
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestBase32Flag_Set(t *testing.T) {
+	cases := []struct {
+		input    string
+		check    bool
+		expected Base32
+		err      error
+	}{
+		{"2T", false, "2T", nil},
+		{"00-2t", false, "2T", nil},
+		{"oIl", false, "11", nil},
+		{"ZZZZZZZZZZZZZZZZ", false, "ZZZZZZZZZZZZZZZZ", nil},
+		{"2TG", true, "2T", nil},
+		{"2-tg", true, "2T", nil},
+		{"ZZZZZZZZZZZZZZZZ~", true, "ZZZZZZZZZZZZZZZZ", nil},
+		{"", false, InvalidBase32Value, decodeEmptyString},
+		{"2U", false, InvalidBase32Value, decodeInvalidDigit},
+		{"2TH", true, InvalidBase32Value, checkNoMatch},
+		{"G", true, InvalidBase32Value, checkMissing},
+		{"2T-G", true, InvalidBase32Value, checkMissing},
+		{"2T%", true, InvalidBase32Value, invalidCheckDigit},
+	}
+	for _, c := range cases {
+		f := Base32Flag{Check: c.check}
+		err := f.Set(c.input)
+		if !errors.Is(err, c.err) {
+			t.Errorf("Expected Set(%q) to return error %v, got %v.", c.input, c.err, err)
+		}
+		if f.Value != c.expected {
+			t.Errorf("Expected Set(%q) to give %q, got %q.", c.input, c.expected, f.Value)
+		}
+	}
+}
+
+func TestBase32Flag_Set_InvalidDigitError(t *testing.T) {
+	var f Base32Flag
+	err := f.Set("2T-!X")
+
+	var digitErr *InvalidDigitError
+	if !errors.As(err, &digitErr) {
+		t.Fatalf("Expected Set to return an *InvalidDigitError, got %v.", err)
+	}
+	if digitErr.Offset != 3 || digitErr.Input != "2T-!X" {
+		t.Errorf("Expected the error at offset 3 of %q, got %+v.", "2T-!X", digitErr)
+	}
+	if expected := `Invalid Base32 digit '!' at offset 3 in "2T-!X"`; err.Error() != expected {
+		t.Errorf("Expected error %q, got %q.", expected, err.Error())
+	}
+}
+
+func TestBase32Flag_String(t *testing.T) {
+	cases := []struct {
+		flag     *Base32Flag
+		expected string
+	}{
+		{nil, ""},
+		{&Base32Flag{}, ""},
+		{&Base32Flag{Value: "2T"}, "2T"},
+		{&Base32Flag{Value: "2T", Check: true}, "2TG"},
+		{&Base32Flag{Value: "ZZZZZZZZZZZZZZZZ", Check: true}, "ZZZZZZZZZZZZZZZZ~"},
+	}
+	for _, c := range cases {
+		if actual := c.flag.String(); actual != c.expected {
+			t.Errorf("Expected String() of %+v to be %q, got %q.", c.flag, c.expected, actual)
+		}
+	}
+}
+
+func TestUint64Flag_Set(t *testing.T) {
+	cases := []struct {
+		input    string
+		check    bool
+		expected uint64
+		err      error
+	}{
+		{"2T", false, 90, nil},
+		{"0-2t", false, 90, nil},
+		{"FZZZZZZZZZZZZ", false, maxUint64Value, nil},
+		{"2TG", true, 90, nil},
+		{"FZZZZZZZZZZZZB", true, maxUint64Value, nil},
+		{"G0000000000000", false, 0, decodeTooBig64},
+		{"2TH", true, 0, checkNoMatch},
+		{"2*", false, 0, decodeInvalidDigit},
+	}
+	for _, c := range cases {
+		f := Uint64Flag{Check: c.check}
+		err := f.Set(c.input)
+		if !errors.Is(err, c.err) {
+			t.Errorf("Expected Set(%q) to return error %v, got %v.", c.input, c.err, err)
+		}
+		if f.Value != c.expected {
+			t.Errorf("Expected Set(%q) to give %d, got %d.", c.input, c.expected, f.Value)
+		}
+	}
+}
+
+func TestUint64Flag_String(t *testing.T) {
+	cases := []struct {
+		flag     *Uint64Flag
+		expected string
+	}{
+		{nil, ""},
+		{&Uint64Flag{}, "0"},
+		{&Uint64Flag{Value: 90}, "2T"},
+		{&Uint64Flag{Value: 90, Check: true}, "2TG"},
+		{&Uint64Flag{Value: maxUint64Value, Check: true}, "FZZZZZZZZZZZZB"},
+	}
+	for _, c := range cases {
+		if actual := c.flag.String(); actual != c.expected {
+			t.Errorf("Expected String() of %+v to be %q, got %q.", c.flag, c.expected, actual)
+		}
+	}
+}
+
+func TestFlagSet(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var id = Base32Flag{Check: true}
+	var num Uint64Flag
+	var text = Base32Flag{Value: "1"}
+	fs.Var(&id, "id", "")
+	fs.Var(&num, "num", "")
+	fs.TextVar(&text, "text", &Base32Flag{Value: "1"}, "")
+
+	if err := fs.Parse([]string{"-id", "2tg", "-num", "2t", "-text", "o-ab"}); err != nil {
+		t.Fatalf("Expected Parse to succeed, got %v.", err)
+	}
+	if id.Value != "2T" || num.Value != 90 || text.Value != "AB" {
+		t.Errorf("Expected 2T, 90 and AB, got %q, %d and %q.", id.Value, num.Value, text.Value)
+	}
+
+	if err := fs.Parse([]string{"-id", "2th"}); err == nil {
+		t.Errorf("Expected Parse to reject a bad check symbol.")
+	}
+}
+
+func BenchmarkBase32Flag_Set(b *testing.B) {
+	b.ReportAllocs()
+	f := Base32Flag{Check: true}
+	for i := 0; i < b.N; i++ {
+		f.Set("1A2B3C2")
+	}
+}
+
+func ExampleBase32Flag() {
+	fs := flag.NewFlagSet("ship", flag.ExitOnError)
+
+	var order = Base32Flag{Check: true}
+	fs.Var(&order, "order", "the order `ID`, with its check symbol")
+	fs.Parse([]string{"-order", "1a2b-3c2"})
+
+	fmt.Println(order.Value, order.String())
+	// Output: 1A2B3C 1A2B3C2
+}
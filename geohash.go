@@ -0,0 +1,290 @@
+package base32
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// MaxGeohashPrecision is the longest supported geohash. 12 digits is 60 bits, which is less than 4
+// centimeters of precision at the equator.
+const MaxGeohashPrecision = 12
+
+// GeohashCover refuses boxes that need more than this many cells.
+const maxGeohashCoverCells = 1 << 16
+
+var (
+	geohashPrecision  = errors.New("Geohash precision must be between 1 and 12 digits")
+	geohashDirection  = errors.New("Invalid geohash direction")
+	geohashEmpty      = errors.New("Cannot decode empty geohash")
+	geohashCoverLarge = errors.New("The bounding box needs too many geohash cells at that precision")
+)
+
+// A GeohashBox is a latitude/longitude bounding box, in degrees.
+//
+// A box with MinLon greater than MaxLon crosses the 180th meridian.
+type GeohashBox struct {
+	MinLat, MaxLat float64
+	MinLon, MaxLon float64
+}
+
+// Center returns the point in the middle of the box.
+func (box GeohashBox) Center() (lat, lon float64) {
+	return (box.MinLat + box.MaxLat) / 2, (box.MinLon + box.MaxLon) / 2
+}
+
+// Directions for GeohashNeighbor, in the order that GeohashNeighbors returns
+// them.
+const (
+	GeohashNorth = iota
+	GeohashNorthEast
+	GeohashEast
+	GeohashSouthEast
+	GeohashSouth
+	GeohashSouthWest
+	GeohashWest
+	GeohashNorthWest
+)
+
+// The latitude and longitude steps for each direction.
+var geohashDirections = [8][2]int64{
+	GeohashNorth:     {1, 0},
+	GeohashNorthEast: {1, 1},
+	GeohashEast:      {0, 1},
+	GeohashSouthEast: {-1, 1},
+	GeohashSouth:     {-1, 0},
+	GeohashSouthWest: {-1, -1},
+	GeohashWest:      {0, -1},
+	GeohashNorthWest: {1, -1},
+}
+
+// EncodeGeohash returns the geohash of a point with the given number of digits.
+//
+// A geohash interleaves the bits of the longitude and latitude, longitude
+// first, and writes the result 5 bits per digit in the Geohash alphabet.
+// Latitudes outside of [-90, 90] and longitudes outside of [-180, 180] are
+// clamped. An error is returned if `precision` is not between 1 and
+// MaxGeohashPrecision.
+func EncodeGeohash(lat, lon float64, precision int) (string, error) {
+	if precision < 1 || precision > MaxGeohashPrecision {
+		return "", geohashPrecision
+	}
+
+	latBits, lonBits := geohashBits(precision)
+	latInt := geohashQuantize(lat, -90, 90, latBits)
+	lonInt := geohashQuantize(lon, -180, 180, lonBits)
+
+	return geohashFromInts(latInt, lonInt, precision), nil
+}
+
+// DecodeGeohash returns the bounding box of a geohash. Letters are case
+// insensitive. An error is returned if the geohash is empty, too long, or has
+// a digit that isn't in the Geohash alphabet.
+func DecodeGeohash(hash string) (GeohashBox, error) {
+	latInt, lonInt, err := geohashToInts(hash)
+	if err != nil {
+		return GeohashBox{}, err
+	}
+
+	latBits, lonBits := geohashBits(len(hash))
+	latStep := 180 / float64(uint64(1)<<latBits)
+	lonStep := 360 / float64(uint64(1)<<lonBits)
+
+	return GeohashBox{
+		MinLat: float64(latInt)*latStep - 90,
+		MaxLat: float64(latInt+1)*latStep - 90,
+		MinLon: float64(lonInt)*lonStep - 180,
+		MaxLon: float64(lonInt+1)*lonStep - 180,
+	}, nil
+}
+
+// GeohashNeighbor returns the geohash of the same precision next to `hash` in
+// the given direction, such as GeohashNorth or GeohashSouthWest. Longitude
+// wraps around the 180th meridian. There is nothing north of the northernmost
+// cells or south of the southernmost cells, so the empty string is returned
+// for those.
+func GeohashNeighbor(hash string, direction int) (string, error) {
+	if direction < GeohashNorth || direction > GeohashNorthWest {
+		return "", geohashDirection
+	}
+
+	latInt, lonInt, err := geohashToInts(hash)
+	if err != nil {
+		return "", err
+	}
+
+	latBits, lonBits := geohashBits(len(hash))
+	step := geohashDirections[direction]
+
+	lat := int64(latInt) + step[0]
+	if lat < 0 || lat >= int64(1)<<latBits {
+		return "", nil
+	}
+	lon := (int64(lonInt) + step[1]) & (int64(1)<<lonBits - 1)
+
+	return geohashFromInts(uint64(lat), uint64(lon), len(hash)), nil
+}
+
+// GeohashNeighbors returns all eight neighbors of `hash`, indexed by direction
+// (GeohashNorth, GeohashNorthEast, ... GeohashNorthWest). See GeohashNeighbor.
+func GeohashNeighbors(hash string) ([8]string, error) {
+	var neighbors [8]string
+	for direction := range neighbors {
+		neighbor, err := GeohashNeighbor(hash, direction)
+		if err != nil {
+			return [8]string{}, err
+		}
+		neighbors[direction] = neighbor
+	}
+	return neighbors, nil
+}
+
+// GeohashCover returns a set of geohash prefixes that together cover `box`.
+// Every cell of the given precision that overlaps the box is covered. When
+// all 32 cells sharing a prefix are needed, the shorter prefix is returned
+// instead, so the result is suitable for prefix searches.
+//
+// An error is returned if the precision is invalid or if the box would need
+// more than 65536 cells at that precision.
+func GeohashCover(box GeohashBox, precision int) ([]string, error) {
+	if precision < 1 || precision > MaxGeohashPrecision {
+		return nil, geohashPrecision
+	}
+
+	latBits, lonBits := geohashBits(precision)
+	minLat := geohashQuantize(box.MinLat, -90, 90, latBits)
+	maxLat := geohashQuantize(box.MaxLat, -90, 90, latBits)
+	minLon := geohashQuantize(box.MinLon, -180, 180, lonBits)
+	maxLon := geohashQuantize(box.MaxLon, -180, 180, lonBits)
+
+	if minLat > maxLat {
+		minLat, maxLat = maxLat, minLat
+	}
+
+	// The number of longitude cells, taking a crossing of the 180th meridian
+	// into account.
+	lonCells := maxLon - minLon + 1
+	if box.MinLon > box.MaxLon {
+		lonCells = (uint64(1) << lonBits) - minLon + maxLon + 1
+	}
+
+	if lonCells*(maxLat-minLat+1) > maxGeohashCoverCells {
+		return nil, geohashCoverLarge
+	}
+
+	var cells = make(map[string]bool)
+	for lat := minLat; lat <= maxLat; lat++ {
+		for i := uint64(0); i < lonCells; i++ {
+			lon := (minLon + i) & (uint64(1)<<lonBits - 1)
+			cells[geohashFromInts(lat, lon, precision)] = true
+		}
+	}
+
+	// Replace complete sets of 32 siblings with their parent, one level at a
+	// time.
+	for length := precision; length > 1; length-- {
+		siblings := make(map[string]int)
+		for cell := range cells {
+			if len(cell) == length {
+				siblings[cell[:length-1]]++
+			}
+		}
+		for parent, count := range siblings {
+			if count != 32 {
+				continue
+			}
+			for _, digit := range Geohash.encode {
+				delete(cells, parent+string(digit))
+			}
+			cells[parent] = true
+		}
+	}
+
+	var result = make([]string, 0, len(cells))
+	for cell := range cells {
+		result = append(result, cell)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// geohashBits returns the number of latitude and longitude bits in a geohash
+// with the given number of digits. Longitude gets the extra bit when the total
+// is odd.
+func geohashBits(precision int) (latBits, lonBits uint) {
+	total := uint(precision * 5)
+	return total / 2, total - total/2
+}
+
+// geohashQuantize maps `value` in [min, max] to an integer cell in
+// [0, 2^bits).
+func geohashQuantize(value, min, max float64, bits uint) uint64 {
+	cells := float64(uint64(1) << bits)
+	cell := math.Floor((value - min) / (max - min) * cells)
+	if cell < 0 || math.IsNaN(cell) {
+		return 0
+	}
+	if cell >= cells {
+		return uint64(1)<<bits - 1
+	}
+	return uint64(cell)
+}
+
+// geohashFromInts interleaves the latitude and longitude cells and renders the
+// result in the Geohash alphabet.
+func geohashFromInts(latInt, lonInt uint64, precision int) string {
+	latBits, lonBits := geohashBits(precision)
+
+	// Interleave the bits, longitude first, most significant first.
+	var bits uint64
+	var total = latBits + lonBits
+	for i := uint(0); i < total; i++ {
+		if i%2 == 0 {
+			lonBits--
+			bits = bits<<1 | (lonInt >> lonBits & 1)
+		} else {
+			latBits--
+			bits = bits<<1 | (latInt >> latBits & 1)
+		}
+	}
+
+	const fiveOnes uint64 = 31 // Binary 11111
+
+	var buffer [MaxGeohashPrecision]byte
+	for i := precision - 1; i >= 0; i-- {
+		buffer[i] = Geohash.encode[bits&fiveOnes]
+		bits >>= 5
+	}
+	return string(buffer[:precision])
+}
+
+// geohashToInts is the opposite of geohashFromInts.
+func geohashToInts(hash string) (latInt, lonInt uint64, err error) {
+	if len(hash) == 0 {
+		return 0, 0, geohashEmpty
+	}
+	if len(hash) > MaxGeohashPrecision {
+		return 0, 0, geohashPrecision
+	}
+
+	for i := 0; i < len(hash); i++ {
+		val := Geohash.decode[hash[i]]
+		if val == invalidAlphabetValue {
+			return 0, 0, decodeInvalidDigit
+		}
+
+		// Each digit's 5 bits alternate between longitude and latitude,
+		// continuing where the last digit left off.
+		for bit := 4; bit >= 0; bit-- {
+			b := uint64(val>>uint(bit)) & 1
+			if (i*5+4-bit)%2 == 0 {
+				lonInt = lonInt<<1 | b
+			} else {
+				latInt = latInt<<1 | b
+			}
+		}
+	}
+
+	return latInt, lonInt, nil
+}
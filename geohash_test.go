@@ -0,0 +1,157 @@
+package base32
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeGeohash(t *testing.T) {
+	cases := []struct {
+		lat, lon  float64
+		precision int
+		expected  string
+	}{
+		{57.64911, 10.40744, 11, "u4pruydqqvj"},
+		{57.64911, 10.40744, 5, "u4pru"},
+		{0, 0, 1, "s"},
+		{-90, -180, 12, "000000000000"},
+		{90, 180, 12, "zzzzzzzzzzzz"},
+		{100, 200, 2, "zz"}, // Clamped.
+	}
+
+	for _, c := range cases {
+		actual, err := EncodeGeohash(c.lat, c.lon, c.precision)
+		if err != nil || actual != c.expected {
+			t.Errorf("Expected EncodeGeohash(%v, %v, %d) to be %q, <nil>; got %q, %v.",
+				c.lat, c.lon, c.precision, c.expected, actual, err)
+		}
+	}
+
+	for _, precision := range []int{0, 13} {
+		if _, err := EncodeGeohash(0, 0, precision); err != geohashPrecision {
+			t.Errorf("Expected EncodeGeohash precision %d to return %v, got %v.",
+				precision, geohashPrecision, err)
+		}
+	}
+}
+
+func TestDecodeGeohash(t *testing.T) {
+	box, err := DecodeGeohash("U4PRU")
+	expected := GeohashBox{57.6123046875, 57.65625, 10.37109375, 10.4150390625}
+	if err != nil || box != expected {
+		t.Errorf("Expected DecodeGeohash(\"U4PRU\") to be %+v, <nil>; got %+v, %v.",
+			expected, box, err)
+	}
+
+	invalid := []struct {
+		input string
+		err   error
+	}{
+		{"", geohashEmpty},
+		{"u4pruydqqvjxx", geohashPrecision},
+		{"u4pra", decodeInvalidDigit},
+	}
+
+	for _, c := range invalid {
+		if _, err := DecodeGeohash(c.input); err != c.err {
+			t.Errorf("Expected DecodeGeohash(%q) to return %v, got %v.", c.input, c.err, err)
+		}
+	}
+
+	// A point must be inside the box of its own geohash.
+	for i := 0; i < 10000; i++ {
+		lat := rand.Float64()*180 - 90
+		lon := rand.Float64()*360 - 180
+		precision := rand.Intn(MaxGeohashPrecision) + 1
+
+		hash, _ := EncodeGeohash(lat, lon, precision)
+		box, err := DecodeGeohash(hash)
+		if err != nil || lat < box.MinLat || lat > box.MaxLat || lon < box.MinLon || lon > box.MaxLon {
+			t.Fatalf("Expected (%v, %v) to be inside DecodeGeohash(%q), got %+v, %v.",
+				lat, lon, hash, box, err)
+		}
+	}
+}
+
+func TestGeohashNeighbors(t *testing.T) {
+	neighbors, err := GeohashNeighbors("u4pru")
+	expected := [8]string{"u4r2h", "u4r2j", "u4prv", "u4prt", "u4prs", "u4pre", "u4prg", "u4r25"}
+	if err != nil || neighbors != expected {
+		t.Errorf("Expected GeohashNeighbors(\"u4pru\") to be %v, <nil>; got %v, %v.",
+			expected, neighbors, err)
+	}
+
+	cases := []struct {
+		hash      string
+		direction int
+		expected  string
+	}{
+		{"xb", GeohashEast, "80"}, // Wraps around the 180th meridian.
+		{"80", GeohashWest, "xb"},
+		{"u", GeohashNorth, ""}, // Nothing north of the north pole.
+		{"0", GeohashSouthWest, ""},
+	}
+
+	for _, c := range cases {
+		actual, err := GeohashNeighbor(c.hash, c.direction)
+		if err != nil || actual != c.expected {
+			t.Errorf("Expected GeohashNeighbor(%q, %d) to be %q, <nil>; got %q, %v.",
+				c.hash, c.direction, c.expected, actual, err)
+		}
+	}
+
+	if _, err := GeohashNeighbor("u", 8); err != geohashDirection {
+		t.Errorf("Expected GeohashNeighbor with an invalid direction to return %v, got %v.", geohashDirection, err)
+	}
+}
+
+func TestGeohashCover(t *testing.T) {
+	// The exact box of one cell.
+	box, _ := DecodeGeohash("u4pr")
+	cover, err := GeohashCover(GeohashBox{box.MinLat, box.MaxLat - 1e-9, box.MinLon, box.MaxLon - 1e-9}, 5)
+	if err != nil || fmt.Sprint(cover) != "[u4pr]" {
+		t.Errorf("Expected GeohashCover of cell u4pr to be [u4pr], got %v, %v.", cover, err)
+	}
+
+	// A small box around a point that straddles cells.
+	cover, err = GeohashCover(GeohashBox{57.65, 57.66, 10.41, 10.42}, 5)
+	if err != nil || fmt.Sprint(cover) != "[u4pru u4prv u4r2h u4r2j]" {
+		t.Errorf("Expected GeohashCover to be [u4pru u4prv u4r2h u4r2j], got %v, %v.", cover, err)
+	}
+
+	// A box crossing the 180th meridian.
+	cover, err = GeohashCover(GeohashBox{0, 1, 179, -179}, 2)
+	if err != nil || fmt.Sprint(cover) != "[80 xb]" {
+		t.Errorf("Expected GeohashCover across the meridian to be [80 xb], got %v, %v.", cover, err)
+	}
+
+	// The whole world.
+	cover, err = GeohashCover(GeohashBox{-90, 90, -180, 180}, 2)
+	if err != nil || len(cover) != 32 || cover[0] != "0" {
+		t.Errorf("Expected GeohashCover of the world to be the 32 top-level cells, got %v, %v.", cover, err)
+	}
+
+	if _, err = GeohashCover(GeohashBox{-90, 90, -180, 180}, 6); err != geohashCoverLarge {
+		t.Errorf("Expected GeohashCover of a huge box to return %v, got %v.", geohashCoverLarge, err)
+	}
+}
+
+func BenchmarkEncodeGeohash(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = EncodeGeohash(57.64911, 10.40744, 11)
+	}
+}
+
+func ExampleEncodeGeohash() {
+	hash, _ := EncodeGeohash(57.64911, 10.40744, 11)
+	fmt.Println(hash)
+
+	box, _ := DecodeGeohash(hash)
+	lat, lon := box.Center()
+	fmt.Printf("%.5f %.5f\n", lat, lon)
+	// Output:
+	// u4pruydqqvj
+	// 57.64911 10.40744
+}
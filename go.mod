@@ -0,0 +1,3 @@
+module github.com/Dancapistan/gobase32
+
+go 1.23
@@ -0,0 +1,128 @@
+package base32
+
+import (
+	"errors"
+	"strings"
+)
+
+var idWrongPrefix = errors.New("The ID does not have the expected prefix")
+
+// A Kind says how the IDs of one kind of entity are written. Kinds are
+// usually empty structs, used only as the type parameter of ID:
+//
+//	type Customer struct{}
+//
+//	func (Customer) Prefix() string { return "cus_" }
+//	func (Customer) Width() int     { return 8 }
+//	func (Customer) Check() bool    { return true }
+//
+//	type CustomerID = base32.ID[Customer]
+//
+// The methods are called on the zero value of the Kind, and must always
+// return the same results.
+type Kind interface {
+	// Prefix is written before the digits, like "cus_". It may be empty.
+	Prefix() string
+
+	// Width is the minimum number of digits. Shorter values are padded with
+	// leading zeros. Zero means no padding.
+	Width() int
+
+	// Check says whether a check symbol is written after the digits, and
+	// required when parsing.
+	Check() bool
+}
+
+// An ID is a uint64 identifier for one kind of entity, written in Base32 the
+// way its Kind says. Because the Kind is part of the type, the compiler
+// won't let an ID[Customer] be used where an ID[Order] is expected, even
+// though both are just numbers.
+//
+// IDs are comparable and can be used as map keys. The zero ID has the value
+// 0. IDs implement encoding.TextMarshaler and encoding.TextUnmarshaler, so
+// they are written as text by encoding/json and friends.
+type ID[K Kind] struct {
+	value uint64
+}
+
+// NewID returns the ID of kind K with the given value.
+func NewID[K Kind](value uint64) ID[K] {
+	return ID[K]{value: value}
+}
+
+// ParseID reads an ID of kind K written by ID.String. The prefix must match
+// exactly. The digits are normalized like FromString normalizes them, and
+// may have leading zeros beyond the Kind's Width. If the Kind uses check
+// symbols, the last character must be a matching check symbol.
+//
+// An error is returned if the prefix is wrong, if the digits are invalid (an
+// *InvalidDigitError) or too big for a uint64, or if the check symbol is
+// missing or wrong.
+func ParseID[K Kind](input string) (ID[K], error) {
+	var kind K
+
+	digits, ok := strings.CutPrefix(input, kind.Prefix())
+	if !ok {
+		return ID[K]{}, idWrongPrefix
+	}
+
+	digits, check, err := splitCheck(digits, kind.Check())
+	if err != nil {
+		return ID[K]{}, err
+	}
+
+	code, err := CodeFromString(digits)
+	if err != nil {
+		return ID[K]{}, err
+	}
+	value, _ := code.Decode()
+
+	if kind.Check() && check != checkOf64(value) {
+		return ID[K]{}, checkNoMatch
+	}
+
+	return ID[K]{value: value}, nil
+}
+
+// Value returns the numeric value of the ID.
+func (id ID[K]) Value() uint64 {
+	return id.value
+}
+
+// String returns the ID written with its Kind's prefix, width and check
+// symbol, like "cus_00002TG".
+func (id ID[K]) String() string {
+	return string(id.appendText(nil))
+}
+
+// MarshalText implements encoding.TextMarshaler. It is the same as String.
+func (id ID[K]) MarshalText() ([]byte, error) {
+	return id.appendText(nil), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It is the same as
+// ParseID.
+func (id *ID[K]) UnmarshalText(text []byte) error {
+	parsed, err := ParseID[K](string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// appendText appends the text form of the ID to `dst`.
+func (id ID[K]) appendText(dst []byte) []byte {
+	var kind K
+	var code = EncodeCode(id.value)
+
+	dst = append(dst, kind.Prefix()...)
+	for i := code.Len(); i < kind.Width(); i++ {
+		dst = append(dst, '0')
+	}
+	dst = code.AppendTo(dst)
+	if kind.Check() {
+		dst = append(dst, byte(checkOf64(id.value)))
+	}
+	return dst
+}
@@ -0,0 +1,127 @@
+package base32
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type testCustomer struct{}
+
+func (testCustomer) Prefix() string { return "cus_" }
+func (testCustomer) Width() int     { return 6 }
+func (testCustomer) Check() bool    { return true }
+
+type testOrder struct{}
+
+func (testOrder) Prefix() string { return "" }
+func (testOrder) Width() int     { return 0 }
+func (testOrder) Check() bool    { return false }
+
+func TestID_String(t *testing.T) {
+	cases := []struct {
+		id       fmt.Stringer
+		expected string
+	}{
+		{NewID[testCustomer](0), "cus_0000000"},
+		{NewID[testCustomer](90), "cus_00002TG"},
+		{NewID[testCustomer](maxUint64Value), "cus_FZZZZZZZZZZZZB"},
+		{NewID[testOrder](0), "0"},
+		{NewID[testOrder](90), "2T"},
+	}
+	for _, c := range cases {
+		if actual := c.id.String(); actual != c.expected {
+			t.Errorf("Expected ID to be %q, got %q.", c.expected, actual)
+		}
+	}
+}
+
+func TestParseID(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected uint64
+		err      error
+	}{
+		{"cus_00002TG", 90, nil},
+		{"cus_2tg", 90, nil},
+		{"cus_0-0-2-TG", 90, nil},
+		{"cus_FZZZZZZZZZZZZB", maxUint64Value, nil},
+		{"cus_00002TH", 0, checkNoMatch},
+		{"cus_G", 0, checkMissing},
+		{"cus_", 0, decodeEmptyString},
+		{"cus_2UG", 0, decodeInvalidDigit},
+		{"cus_G0000000000000", 0, decodeTooBig64},
+		{"ord_00002TG", 0, idWrongPrefix},
+		{"CUS_00002TG", 0, idWrongPrefix},
+		{"00002TG", 0, idWrongPrefix},
+	}
+	for _, c := range cases {
+		id, err := ParseID[testCustomer](c.input)
+		if !errors.Is(err, c.err) {
+			t.Errorf("Expected ParseID(%q) to return error %v, got %v.", c.input, c.err, err)
+		}
+		if id.Value() != c.expected {
+			t.Errorf("Expected ParseID(%q) to be %d, got %d.", c.input, c.expected, id.Value())
+		}
+	}
+}
+
+func TestID_Text(t *testing.T) {
+	type order struct {
+		ID       ID[testOrder]
+		Customer ID[testCustomer]
+	}
+
+	var sent = order{ID: NewID[testOrder](1234), Customer: NewID[testCustomer](90)}
+	data, err := json.Marshal(sent)
+	if expected := `{"ID":"16J","Customer":"cus_00002TG"}`; err != nil || string(data) != expected {
+		t.Errorf("Expected json.Marshal to give %s, got %s (%v).", expected, data, err)
+	}
+
+	var received order
+	if err := json.Unmarshal(data, &received); err != nil || received != sent {
+		t.Errorf("Expected json.Unmarshal to give %+v, got %+v (%v).", sent, received, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Customer":"cus_00002TH"}`), &received); err == nil {
+		t.Errorf("Expected json.Unmarshal to reject a wrong check symbol.")
+	}
+}
+
+func BenchmarkID_String(b *testing.B) {
+	b.ReportAllocs()
+	id := NewID[testCustomer](1234567890)
+	for i := 0; i < b.N; i++ {
+		_ = id.String()
+	}
+}
+
+func BenchmarkParseID(b *testing.B) {
+	b.ReportAllocs()
+	input := NewID[testCustomer](1234567890).String()
+	for i := 0; i < b.N; i++ {
+		ParseID[testCustomer](input)
+	}
+}
+
+type Customer struct{}
+
+func (Customer) Prefix() string { return "cus_" }
+func (Customer) Width() int     { return 8 }
+func (Customer) Check() bool    { return true }
+
+func ExampleID() {
+	id := NewID[Customer](90)
+	fmt.Println(id)
+
+	parsed, err := ParseID[Customer]("CUS_2TG")
+	fmt.Println(parsed.Value(), err)
+
+	parsed, err = ParseID[Customer]("cus_2tg")
+	fmt.Println(parsed.Value(), err)
+	// Output:
+	// cus_0000002TG
+	// 0 The ID does not have the expected prefix
+	// 90 <nil>
+}
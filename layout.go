@@ -0,0 +1,256 @@
+package base32
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// A Field is a named, fixed-width group of bits in a Layout.
+type Field struct {
+	Name string
+	Bits uint
+}
+
+// A Layout packs several small unsigned integer fields into a single Base32
+// value. Fields are packed in order, with the first field in the most
+// significant bits, so Base32 values that share the first field also share
+// their leading digits (once padded to the same width).
+//
+// The total width of all fields can't be more than 32 bits, since the packed
+// value is encoded with Encode.
+//
+// For example, a shipment code with a 6-bit warehouse, a 9-bit day of the year
+// and a 17-bit counter:
+//
+//	layout, err := NewLayout(
+//		Field{"Warehouse", 6},
+//		Field{"Day", 9},
+//		Field{"Counter", 17},
+//	)
+//	code, err := layout.Pack(map[string]uint32{"Warehouse": 3, "Day": 200, "Counter": 1})
+type Layout struct {
+	fields []Field
+	bits   uint
+}
+
+// A FieldOverflowError is returned when a value is too big to fit in its field.
+type FieldOverflowError struct {
+	Field string
+	Value uint64
+	Bits  uint
+}
+
+func (e *FieldOverflowError) Error() string {
+	return fmt.Sprintf("Value %d is too big for the %d-bit field %q", e.Value, e.Bits, e.Field)
+}
+
+var (
+	layoutTooWide      = errors.New("A layout can't be more than 32 bits wide")
+	layoutValueTooBig  = errors.New("Base 32 value is too big for the layout")
+	layoutEmptyField   = errors.New("Layout fields must have a name and at least 1 bit")
+	layoutNotStruct    = errors.New("Expected a struct or a pointer to a struct")
+	layoutNotStructPtr = errors.New("Expected a non-nil pointer to a struct")
+)
+
+// NewLayout returns a Layout for the given fields, most significant first. An
+// error is returned if a field has no name or no bits, if two fields have the
+// same name, or if the fields add up to more than 32 bits.
+func NewLayout(fields ...Field) (*Layout, error) {
+	var layout = &Layout{fields: make([]Field, len(fields))}
+	var names = make(map[string]bool, len(fields))
+
+	for i, field := range fields {
+		if field.Name == "" || field.Bits == 0 {
+			return nil, layoutEmptyField
+		}
+		if names[field.Name] {
+			return nil, fmt.Errorf("Duplicate layout field %q", field.Name)
+		}
+		names[field.Name] = true
+
+		layout.fields[i] = field
+		layout.bits += field.Bits
+		if layout.bits > 32 {
+			return nil, layoutTooWide
+		}
+	}
+
+	return layout, nil
+}
+
+// LayoutOf returns a Layout built from the `b32` struct tags of `v`, which must
+// be a struct or a pointer to a struct. Each tagged field becomes a layout
+// field, in declaration order, named after the Go field:
+//
+//	type Shipment struct {
+//		Warehouse uint8  `b32:"bits=6"`
+//		Day       uint16 `b32:"bits=9"`
+//		Counter   uint32 `b32:"bits=17"`
+//	}
+//
+// Tagged fields must be exported unsigned integers. Untagged fields are
+// ignored.
+func LayoutOf(v interface{}) (*Layout, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, layoutNotStruct
+	}
+
+	var fields []Field
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag, ok := structField.Tag.Lookup("b32")
+		if !ok {
+			continue
+		}
+		if !structField.IsExported() {
+			return nil, fmt.Errorf("Layout field %q must be exported", structField.Name)
+		}
+		if !isUnsigned(structField.Type.Kind()) {
+			return nil, fmt.Errorf("Layout field %q must be an unsigned integer", structField.Name)
+		}
+		if !strings.HasPrefix(tag, "bits=") {
+			return nil, fmt.Errorf("Invalid b32 tag %q on field %q", tag, structField.Name)
+		}
+		bits, err := strconv.ParseUint(tag[len("bits="):], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid b32 tag %q on field %q", tag, structField.Name)
+		}
+		fields = append(fields, Field{Name: structField.Name, Bits: uint(bits)})
+	}
+
+	return NewLayout(fields...)
+}
+
+// Fields returns a copy of the layout's fields, most significant first.
+func (l *Layout) Fields() []Field {
+	return append([]Field(nil), l.fields...)
+}
+
+// Pack packs the named values into a single Base32 value. Fields missing from
+// `values` are packed as 0. An error is returned if `values` has a name that
+// isn't in the layout, or a *FieldOverflowError if a value doesn't fit in its
+// field.
+func (l *Layout) Pack(values map[string]uint32) (Base32, error) {
+	for name := range values {
+		if l.index(name) < 0 {
+			return InvalidBase32Value, fmt.Errorf("Unknown layout field %q", name)
+		}
+	}
+
+	var packed uint32
+	for _, field := range l.fields {
+		value := uint64(values[field.Name])
+		if value>>field.Bits != 0 {
+			return InvalidBase32Value, &FieldOverflowError{field.Name, value, field.Bits}
+		}
+		packed = packed<<field.Bits | uint32(value)
+	}
+
+	return Encode(packed), nil
+}
+
+// Unpack is the opposite of Pack. It decodes `num` and splits it into the
+// layout's fields. An error is returned if `num` can't be decoded, or if it
+// has bits set above the width of the layout.
+func (l *Layout) Unpack(num Base32) (map[string]uint32, error) {
+	packed, err := num.Decode()
+	if err != nil {
+		return nil, err
+	}
+	if l.bits < 32 && packed>>l.bits != 0 {
+		return nil, layoutValueTooBig
+	}
+
+	var values = make(map[string]uint32, len(l.fields))
+	for i := len(l.fields) - 1; i >= 0; i-- {
+		field := l.fields[i]
+		values[field.Name] = packed & (1<<field.Bits - 1)
+		packed = uint32(uint64(packed) >> field.Bits)
+	}
+
+	return values, nil
+}
+
+// PackStruct is like Pack, but reads the values from the fields of `v` with
+// the same names as the layout's fields. `v` must be a struct or a pointer to
+// a struct. See LayoutOf.
+func (l *Layout) PackStruct(v interface{}) (Base32, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return InvalidBase32Value, layoutNotStruct
+	}
+
+	var values = make(map[string]uint32, len(l.fields))
+	for _, field := range l.fields {
+		structField := rv.FieldByName(field.Name)
+		if !structField.IsValid() || !isUnsigned(structField.Kind()) {
+			return InvalidBase32Value, fmt.Errorf("Missing unsigned integer field %q", field.Name)
+		}
+		value := structField.Uint()
+		if value>>field.Bits != 0 {
+			return InvalidBase32Value, &FieldOverflowError{field.Name, value, field.Bits}
+		}
+		values[field.Name] = uint32(value)
+	}
+
+	return l.Pack(values)
+}
+
+// UnpackStruct is like Unpack, but stores the values in the fields of `v` with
+// the same names as the layout's fields. `v` must be a non-nil pointer to a
+// struct.
+func (l *Layout) UnpackStruct(num Base32, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return layoutNotStructPtr
+	}
+	rv = rv.Elem()
+
+	values, err := l.Unpack(num)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range l.fields {
+		structField := rv.FieldByName(field.Name)
+		if !structField.IsValid() || !isUnsigned(structField.Kind()) || !structField.CanSet() {
+			return fmt.Errorf("Missing unsigned integer field %q", field.Name)
+		}
+		value := uint64(values[field.Name])
+		if structField.OverflowUint(value) {
+			return &FieldOverflowError{field.Name, value, uint(structField.Type().Bits())}
+		}
+		structField.SetUint(value)
+	}
+
+	return nil
+}
+
+// index returns the position of the named field, or -1.
+func (l *Layout) index(name string) int {
+	for i, field := range l.fields {
+		if field.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// isUnsigned returns true for the unsigned integer kinds.
+func isUnsigned(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package base32
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testShipment struct {
+	Warehouse uint8  `b32:"bits=6"`
+	Day       uint16 `b32:"bits=9"`
+	Counter   uint32 `b32:"bits=17"`
+	Note      string
+}
+
+func TestNewLayout(t *testing.T) {
+	invalid := [][]Field{
+		{{"A", 0}},
+		{{"", 1}},
+		{{"A", 1}, {"A", 2}},
+		{{"A", 30}, {"B", 3}},
+	}
+
+	for _, fields := range invalid {
+		_, err := NewLayout(fields...)
+		if err == nil {
+			t.Errorf("Expected NewLayout(%v) to return an error, got nil.", fields)
+		}
+	}
+}
+
+func TestLayout_Pack(t *testing.T) {
+	layout, err := NewLayout(Field{"Warehouse", 6}, Field{"Day", 9}, Field{"Counter", 17})
+	if err != nil {
+		t.Fatalf("Expected NewLayout to succeed, got error %q.", err)
+	}
+
+	cases := []struct {
+		input    map[string]uint32
+		expected Base32
+	}{
+		{map[string]uint32{}, "0"},
+		{map[string]uint32{"Counter": 90}, "2T"},
+		{map[string]uint32{"Warehouse": 63, "Day": 511, "Counter": 131071}, "3ZZZZZZ"},
+		{map[string]uint32{"Warehouse": 1}, "200000"},
+	}
+
+	for _, c := range cases {
+		actual, err := layout.Pack(c.input)
+		if err != nil || actual != c.expected {
+			t.Errorf("Expected Pack(%v) to be %q, <nil>; got %q, %v.",
+				c.input, c.expected, actual, err)
+		}
+
+		values, err := layout.Unpack(actual)
+		if err != nil {
+			t.Errorf("Expected Unpack(%q) to succeed, got error %q.", actual, err)
+		}
+		for _, field := range layout.Fields() {
+			if values[field.Name] != c.input[field.Name] {
+				t.Errorf("Expected Unpack(%q)[%q] to be %d, got %d.",
+					actual, field.Name, c.input[field.Name], values[field.Name])
+			}
+		}
+	}
+
+	_, err = layout.Pack(map[string]uint32{"Day": 512})
+	if overflow, ok := err.(*FieldOverflowError); !ok || overflow.Field != "Day" {
+		t.Errorf("Expected Pack to return a *FieldOverflowError for Day, got %v.", err)
+	}
+
+	_, err = layout.Pack(map[string]uint32{"Nope": 1})
+	if err == nil {
+		t.Errorf("Expected Pack with an unknown field to return an error, got nil.")
+	}
+
+	narrow, _ := NewLayout(Field{"A", 5})
+	if _, err = narrow.Unpack("10"); err != layoutValueTooBig {
+		t.Errorf("Expected Unpack of a too-wide value to return %v, got %v.", layoutValueTooBig, err)
+	}
+	if _, err = narrow.Unpack("U"); err != decodeInvalidDigit {
+		t.Errorf("Expected Unpack of an invalid value to return %v, got %v.", decodeInvalidDigit, err)
+	}
+}
+
+func TestLayoutOf(t *testing.T) {
+	layout, err := LayoutOf(&testShipment{})
+	if err != nil {
+		t.Fatalf("Expected LayoutOf to succeed, got error %q.", err)
+	}
+
+	expected := []Field{{"Warehouse", 6}, {"Day", 9}, {"Counter", 17}}
+	fields := layout.Fields()
+	if fmt.Sprint(fields) != fmt.Sprint(expected) {
+		t.Errorf("Expected LayoutOf fields to be %v, got %v.", expected, fields)
+	}
+
+	input := testShipment{Warehouse: 3, Day: 200, Counter: 1, Note: "ignored"}
+	code, err := layout.PackStruct(input)
+	if err != nil {
+		t.Fatalf("Expected PackStruct to succeed, got error %q.", err)
+	}
+
+	var output testShipment
+	if err = layout.UnpackStruct(code, &output); err != nil {
+		t.Fatalf("Expected UnpackStruct to succeed, got error %q.", err)
+	}
+	input.Note = ""
+	if output != input {
+		t.Errorf("Expected UnpackStruct(%q) to be %+v, got %+v.", code, input, output)
+	}
+
+	_, err = layout.PackStruct(testShipment{Warehouse: 64})
+	if overflow, ok := err.(*FieldOverflowError); !ok || overflow.Field != "Warehouse" {
+		t.Errorf("Expected PackStruct to return a *FieldOverflowError for Warehouse, got %v.", err)
+	}
+
+	if err = layout.UnpackStruct(code, output); err != layoutNotStructPtr {
+		t.Errorf("Expected UnpackStruct of a non-pointer to return %v, got %v.", layoutNotStructPtr, err)
+	}
+
+	invalid := []interface{}{
+		nil,
+		42,
+		struct {
+			A int `b32:"bits=3"`
+		}{},
+		struct {
+			A uint `b32:"3"`
+		}{},
+		struct {
+			A uint `b32:"bits=x"`
+		}{},
+		struct {
+			a uint `b32:"bits=3"`
+		}{},
+	}
+	for _, v := range invalid {
+		if _, err = LayoutOf(v); err == nil {
+			t.Errorf("Expected LayoutOf(%#v) to return an error, got nil.", v)
+		}
+	}
+}
+
+func ExampleLayout() {
+	layout, _ := NewLayout(
+		Field{"Warehouse", 6},
+		Field{"Day", 9},
+		Field{"Counter", 17},
+	)
+
+	code, _ := layout.Pack(map[string]uint32{"Warehouse": 3, "Day": 200, "Counter": 1})
+	values, _ := layout.Unpack(code)
+
+	fmt.Println(code)
+	fmt.Println(values["Warehouse"], values["Day"], values["Counter"])
+	// Output:
+	// 6S0001
+	// 3 200 1
+}
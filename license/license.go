@@ -0,0 +1,167 @@
+// Package license generates and verifies software license keys written in
+// Crockford-style Base32, like:
+//
+//	1B0H4-PJ7XK-9T2CE-QWM8R
+//
+// A key packs a product ID, a set of edition bits, an expiry date and a
+// truncated HMAC-SHA256 signature into 12 bytes, which is 20 Base32 digits.
+// Keys can be verified offline. Because the signature is an HMAC, the secret
+// needed to verify keys is also enough to generate them, so keep it out of
+// places where users can easily dig it up.
+//
+// Key parsing is forgiving, since keys are often typed by hand: letters are
+// case insensitive, 'O' is read as '0', 'I' and 'L' are read as '1', and
+// hyphens and spaces are ignored.
+package license
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	base32 "github.com/Dancapistan/gobase32"
+)
+
+// Errors returned by Signer.Verify.
+var (
+	ErrMalformed = errors.New("The license key is not well-formed")
+	ErrSignature = errors.New("The license key signature does not match")
+	ErrExpired   = errors.New("The license key has expired")
+)
+
+// The parts of a license key, in bytes.
+const (
+	productSize = 2 // uint16 product ID
+	editionSize = 1 // uint8 edition bits
+	expirySize  = 2 // uint16 days since the Unix epoch
+	tagSize     = 7 // truncated HMAC-SHA256
+	dataSize    = productSize + editionSize + expirySize
+	keySize     = dataSize + tagSize
+)
+
+// The number of Base32 digits in each hyphen-separated group of a key.
+const groupSize = 5
+
+const day = 24 * time.Hour
+
+// A Key holds the licensed details of a license key.
+type Key struct {
+	// Product identifies the licensed product.
+	Product uint16
+
+	// Edition is a set of bits for the application to interpret, such as
+	// the licensed edition or feature flags.
+	Edition uint8
+
+	// Expiry is the last day the key is valid, in UTC. The zero time means
+	// the key never expires. Only the date is stored; the time of day is
+	// dropped. Dates after the year 2149 can't be stored.
+	Expiry time.Time
+}
+
+// A Signer generates and verifies license keys with a secret HMAC key.
+//
+// A Signer is safe for concurrent use.
+type Signer struct {
+	secret []byte
+}
+
+// NewSigner returns a Signer that uses `secret` as the HMAC key. The Signer
+// keeps its own copy of the secret.
+func NewSigner(secret []byte) *Signer {
+	return &Signer{secret: append([]byte(nil), secret...)}
+}
+
+// Generate returns the license key for `key`, formatted as four groups of five
+// Base32 digits separated by hyphens.
+func (s *Signer) Generate(key Key) string {
+	var raw [keySize]byte
+
+	var days uint16
+	if !key.Expiry.IsZero() {
+		// Day 0 is reserved for "never expires", so keys that expire on or
+		// before the epoch are stored as day 1, which is already expired.
+		unixDays := key.Expiry.Unix() / int64(day/time.Second)
+		if unixDays < 1 {
+			unixDays = 1
+		} else if unixDays > 0xffff {
+			unixDays = 0xffff
+		}
+		days = uint16(unixDays)
+	}
+
+	binary.BigEndian.PutUint16(raw[0:], key.Product)
+	raw[productSize] = key.Edition
+	binary.BigEndian.PutUint16(raw[productSize+editionSize:], days)
+	copy(raw[dataSize:], s.tag(raw[:dataSize]))
+
+	return group(base32.EncodeBytes(raw[:]))
+}
+
+// Verify checks a license key and returns its details.
+//
+// Verify returns ErrMalformed if the key can't be parsed, and ErrSignature if
+// the signature is wrong. If the signature is good but the key has expired,
+// Verify returns the key's details along with ErrExpired.
+func (s *Signer) Verify(licenseKey string) (Key, error) {
+	raw, err := base32.DecodeBytes(stripSpaces(licenseKey), keySize)
+	if err != nil {
+		return Key{}, ErrMalformed
+	}
+
+	if !hmac.Equal(raw[dataSize:], s.tag(raw[:dataSize])) {
+		return Key{}, ErrSignature
+	}
+
+	var key = Key{
+		Product: binary.BigEndian.Uint16(raw),
+		Edition: raw[productSize],
+	}
+
+	days := binary.BigEndian.Uint16(raw[productSize+editionSize:])
+	if days == 0 {
+		return key, nil
+	}
+
+	key.Expiry = time.Unix(int64(days)*int64(day/time.Second), 0).UTC()
+	if time.Now().After(key.Expiry.Add(day)) {
+		return key, ErrExpired
+	}
+
+	return key, nil
+}
+
+// tag returns the truncated HMAC-SHA256 tag of `data`.
+func (s *Signer) tag(data []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(data)
+	return mac.Sum(nil)[:tagSize]
+}
+
+// group splits `digits` into hyphen-separated groups of groupSize digits.
+func group(digits string) string {
+	var result = make([]byte, 0, len(digits)+len(digits)/groupSize)
+	for i := 0; i < len(digits); i++ {
+		if i > 0 && i%groupSize == 0 {
+			result = append(result, '-')
+		}
+		result = append(result, digits[i])
+	}
+	return string(result)
+}
+
+// stripSpaces removes whitespace from a hand-typed key. Hyphens are left for
+// base32.DecodeBytes to deal with.
+func stripSpaces(input string) string {
+	var result = make([]byte, 0, len(input))
+	for i := 0; i < len(input); i++ {
+		switch input[i] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		result = append(result, input[i])
+	}
+	return string(result)
+}
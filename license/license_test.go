@@ -0,0 +1,124 @@
+package license
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func TestSigner_Verify(t *testing.T) {
+	signer := NewSigner(testSecret)
+	expiry := time.Date(2100, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	cases := []Key{
+		{Product: 0, Edition: 0},
+		{Product: 42, Edition: 3},
+		{Product: 0xffff, Edition: 0xff, Expiry: expiry},
+		{Product: 7, Edition: 1, Expiry: expiry.Add(13 * time.Hour)}, // Time of day is dropped.
+	}
+
+	for _, c := range cases {
+		licenseKey := signer.Generate(c)
+		if len(licenseKey) != 23 || strings.Count(licenseKey, "-") != 3 {
+			t.Errorf("Expected Generate(%+v) to be formatted XXXXX-XXXXX-XXXXX-XXXXX, got %q.",
+				c, licenseKey)
+		}
+
+		var expected = c
+		if !c.Expiry.IsZero() {
+			expected.Expiry = expiry
+		}
+
+		actual, err := signer.Verify(licenseKey)
+		if err != nil || actual != expected {
+			t.Errorf("Expected Verify(%q) to be %+v, <nil>; got %+v, %v.",
+				licenseKey, expected, actual, err)
+		}
+
+		// Hand-typed keys are cleaned up.
+		sloppy := strings.ToLower(strings.Replace(licenseKey, "-", " ", -1))
+		sloppy = strings.Replace(sloppy, "1", "l", -1)
+		actual, err = signer.Verify(sloppy)
+		if err != nil || actual != expected {
+			t.Errorf("Expected Verify(%q) to be %+v, <nil>; got %+v, %v.",
+				sloppy, expected, actual, err)
+		}
+	}
+}
+
+func TestSigner_VerifyErrors(t *testing.T) {
+	signer := NewSigner(testSecret)
+	licenseKey := signer.Generate(Key{Product: 42})
+
+	// Change one digit of the product ID.
+	tampered := []byte(licenseKey)
+	if tampered[1] == 'A' {
+		tampered[1] = 'B'
+	} else {
+		tampered[1] = 'A'
+	}
+
+	cases := []struct {
+		signer     *Signer
+		licenseKey string
+		err        error
+	}{
+		{signer, "", ErrMalformed},
+		{signer, licenseKey[:22], ErrMalformed},
+		{signer, licenseKey + "0", ErrMalformed},
+		{signer, "U" + licenseKey[1:], ErrMalformed},
+		{signer, string(tampered), ErrSignature},
+		{NewSigner([]byte("some other secret")), licenseKey, ErrSignature},
+	}
+
+	for _, c := range cases {
+		_, err := c.signer.Verify(c.licenseKey)
+		if err != c.err {
+			t.Errorf("Expected Verify(%q) to return error %v, got %v.",
+				c.licenseKey, c.err, err)
+		}
+	}
+}
+
+func TestSigner_VerifyExpired(t *testing.T) {
+	signer := NewSigner(testSecret)
+
+	cases := []time.Time{
+		time.Now().Add(-48 * time.Hour),
+		time.Unix(0, 0),
+		time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, expiry := range cases {
+		licenseKey := signer.Generate(Key{Product: 42, Expiry: expiry})
+		key, err := signer.Verify(licenseKey)
+		if err != ErrExpired || key.Product != 42 {
+			t.Errorf("Expected a key expiring %v to return product 42 and ErrExpired, got %+v, %v.",
+				expiry, key, err)
+		}
+	}
+
+	// A key is good through the end of its expiry day.
+	licenseKey := signer.Generate(Key{Product: 42, Expiry: time.Now()})
+	if _, err := signer.Verify(licenseKey); err != nil {
+		t.Errorf("Expected a key expiring today to be valid, got %v.", err)
+	}
+}
+
+func ExampleSigner() {
+	signer := NewSigner([]byte("use at least 32 random bytes here"))
+
+	licenseKey := signer.Generate(Key{
+		Product: 42,
+		Edition: 1,
+		Expiry:  time.Date(2099, time.December, 31, 0, 0, 0, 0, time.UTC),
+	})
+
+	key, err := signer.Verify(licenseKey)
+	fmt.Println(key.Product, key.Edition, key.Expiry.Format("2006-01-02"), err)
+	// Output:
+	// 42 1 2099-12-31 <nil>
+}
@@ -0,0 +1,103 @@
+package base32
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+// Whether LogValue reports invalid values with an "error" attribute. See
+// SetLogInvalidAsError.
+var logInvalidAsError atomic.Bool
+
+// SetLogInvalidAsError sets how Base32 and Check values that are invalid show
+// up in log/slog output. By default they are logged like String() formats
+// them, which is "<invalid>" for the empty value. When enabled, they are
+// logged as a group with an "error" attribute explaining what is wrong, and
+// the raw value.
+//
+// It is safe to call SetLogInvalidAsError at any time, but it is meant to be
+// set once when the program starts.
+func SetLogInvalidAsError(enabled bool) {
+	logInvalidAsError.Store(enabled)
+}
+
+// LogValue implements slog.LogValuer. A valid value is logged as a group with
+// the normalized digits ("base32"), and, if the value fits in a uint32 (see
+// WillFit), the decoded number ("value") and its check symbol ("check"):
+//
+//	id.base32=2T id.value=90 id.check=G
+//
+// See SetLogInvalidAsError for how invalid values are logged, and
+// RedactHandler for masking logged values.
+func (num Base32) LogValue() slog.Value {
+	normalized, err := FromString(string(num))
+	if err != nil {
+		return logInvalid(num.String(), string(num), err)
+	}
+
+	var attrs = []slog.Attr{slog.String("base32", string(normalized))}
+	if normalized.WillFit() {
+		decoded, _ := normalized.Decode()
+		attrs = append(attrs,
+			slog.Uint64("value", uint64(decoded)),
+			slog.String("check", GenerateCheck(decoded).String()),
+		)
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements slog.LogValuer. A valid check symbol is logged as a
+// string. See SetLogInvalidAsError for how invalid values are logged.
+func (check Check) LogValue() slog.Value {
+	if check == InvalidCheckValue {
+		return logInvalid(check.String(), "", invalidCheckDigit)
+	}
+	normalized, err := CheckFromString(string(check))
+	if err != nil {
+		return logInvalid(check.String(), string(check), err)
+	}
+	return slog.StringValue(normalized.String())
+}
+
+// MarshalText implements encoding.TextMarshaler, so a Check is written as its
+// normalized symbol, rather than a number, by encoding/json and expvar. The
+// InvalidCheckValue marshals to empty text. An error is returned for any other
+// value that isn't a check symbol.
+func (check Check) MarshalText() ([]byte, error) {
+	if check == InvalidCheckValue {
+		return []byte{}, nil
+	}
+	normalized, err := CheckFromString(string(check))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(string(normalized)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It is the opposite of
+// MarshalText: empty text is the InvalidCheckValue, and anything else is read
+// with CheckFromString.
+func (check *Check) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*check = InvalidCheckValue
+		return nil
+	}
+	normalized, err := CheckFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*check = normalized
+	return nil
+}
+
+// logInvalid returns the slog.Value for an invalid value. `formatted` is what
+// String() returns, and `raw` is the raw value.
+func logInvalid(formatted, raw string, err error) slog.Value {
+	if !logInvalidAsError.Load() {
+		return slog.StringValue(formatted)
+	}
+	return slog.GroupValue(
+		slog.String("error", err.Error()),
+		slog.String("raw", raw),
+	)
+}
@@ -0,0 +1,175 @@
+package base32
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+)
+
+// logLine logs `value` with a text handler and returns the output without the
+// trailing newline.
+func logLine(value interface{}) string {
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("m", "id", value)
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func TestBase32_LogValue(t *testing.T) {
+	cases := []struct {
+		input    Base32
+		expected string
+	}{
+		{"2T", "msg=m id.base32=2T id.value=90 id.check=G"},
+		{"00-2t", "msg=m id.base32=2T id.value=90 id.check=G"},
+		{"0", "msg=m id.base32=0 id.value=0 id.check=0"},
+		{"3ZZZZZZ", "msg=m id.base32=3ZZZZZZ id.value=4294967295 id.check=6"},
+		{"4000000", "msg=m id.base32=4000000"},
+		{"2U", "msg=m id=2U"},
+		{InvalidBase32Value, "msg=m id=<invalid>"},
+	}
+	for _, c := range cases {
+		actual := logLine(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected logging %q to give %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestCheck_LogValue(t *testing.T) {
+	cases := []struct {
+		input    Check
+		expected string
+	}{
+		{Check('G'), "msg=m id=G"},
+		{Check('u'), "msg=m id=U"},
+		{Check('o'), "msg=m id=0"},
+		{Check('%'), "msg=m id=%"},
+		{InvalidCheckValue, "msg=m id=<invalid>"},
+	}
+	for _, c := range cases {
+		actual := logLine(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected logging %q to give %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestSetLogInvalidAsError(t *testing.T) {
+	SetLogInvalidAsError(true)
+	defer SetLogInvalidAsError(false)
+
+	cases := []struct {
+		input    interface{}
+		expected string
+	}{
+		{Base32("2U"), `msg=m id.error="` + decodeInvalidDigit.Error() + `" id.raw=2U`},
+		{InvalidBase32Value, `msg=m id.error="` + decodeEmptyString.Error() + `" id.raw=""`},
+		{Check('%'), `msg=m id.error="` + invalidCheckDigit.Error() + `" id.raw=%`},
+		{InvalidCheckValue, `msg=m id.error="` + invalidCheckDigit.Error() + `" id.raw=""`},
+		{Base32("2T"), "msg=m id.base32=2T id.value=90 id.check=G"},
+	}
+	for _, c := range cases {
+		actual := logLine(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected logging %#v to give %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestCheck_MarshalText(t *testing.T) {
+	cases := []struct {
+		input    Check
+		expected string
+		err      error
+	}{
+		{GenerateCheck(90), `{"C":"G"}`, nil},
+		{Check('u'), `{"C":"U"}`, nil},
+		{Check('o'), `{"C":"0"}`, nil},
+		{Check('~'), `{"C":"~"}`, nil},
+		{InvalidCheckValue, `{"C":""}`, nil},
+		{Check('%'), "", invalidCheckDigit},
+	}
+	for _, c := range cases {
+		actual, err := json.Marshal(struct{ C Check }{c.input})
+		if !errors.Is(err, c.err) {
+			t.Errorf("Expected json.Marshal(%q) to return error %v, got %v.", c.input, c.err, err)
+		}
+		if string(actual) != c.expected {
+			t.Errorf("Expected json.Marshal(%q) to give %s, got %s.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestCheck_UnmarshalText(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Check
+		err      error
+	}{
+		{`{"C":"G"}`, Check('G'), nil},
+		{`{"C":"u"}`, Check('U'), nil},
+		{`{"C":"$"}`, Check('$'), nil},
+		{`{"C":""}`, InvalidCheckValue, nil},
+		{`{"C":"%"}`, InvalidCheckValue, invalidCheckDigit},
+		{`{"C":"GG"}`, InvalidCheckValue, invalidCheckLength},
+	}
+	for _, c := range cases {
+		var actual struct{ C Check }
+		err := json.Unmarshal([]byte(c.input), &actual)
+		if !errors.Is(err, c.err) {
+			t.Errorf("Expected json.Unmarshal(%s) to return error %v, got %v.", c.input, c.err, err)
+		}
+		if actual.C != c.expected {
+			t.Errorf("Expected json.Unmarshal(%s) to give %q, got %q.", c.input, c.expected, actual.C)
+		}
+	}
+}
+
+func TestCheck_MarshalText_RoundTrip(t *testing.T) {
+	for i := 0; i < 37; i++ {
+		var sent = struct{ C Check }{GenerateCheck(uint32(i))}
+		data, err := json.Marshal(sent)
+		if err != nil {
+			t.Fatalf("Expected json.Marshal(%q) to succeed, got %v.", sent.C, err)
+		}
+
+		var received struct{ C Check }
+		if err := json.Unmarshal(data, &received); err != nil || received != sent {
+			t.Errorf("Expected %s to round trip to %q, got %q (%v).", data, sent.C, received.C, err)
+		}
+	}
+}
+
+func BenchmarkBase32_LogValue(b *testing.B) {
+	b.ReportAllocs()
+	num := Base32("1A2B3C")
+	for i := 0; i < b.N; i++ {
+		_ = num.LogValue()
+	}
+}
+
+func ExampleBase32_LogValue() {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("order shipped", "order", Base32("2t"))
+	// Output:
+	// level=INFO msg="order shipped" order.base32=2T order.value=90 order.check=G
+}
@@ -0,0 +1,100 @@
+package base32
+
+import (
+	"errors"
+)
+
+var (
+	mortonDimensions = errors.New("A Morton key needs at least one dimension")
+	mortonLength     = errors.New("Wrong number of Base32 digits for a Morton key of that many dimensions")
+)
+
+// mortonWidth returns the number of Base32 digits in a Morton key with `dims`
+// dimensions.
+func mortonWidth(dims int) int {
+	return (32*dims + 4) / 5
+}
+
+// EncodeMorton interleaves the bits of the coordinates into a single Base32
+// key, also known as a Z-order curve. The most significant bit of every
+// coordinate comes first, then the next bit of every coordinate, and so on.
+//
+// Unlike Encode, the result is never trimmed. It is always ceil(32 * dims / 5)
+// digits long, with any extra bits at the start set to zero. That way keys
+// with the same number of dimensions sort in Z-order, and keys that share a
+// prefix are in the same spatial quadrant (or octant, and so on).
+//
+// EncodeMorton returns InvalidBase32Value if there are no coordinates.
+func EncodeMorton(coords ...uint32) Base32 {
+	var dims = len(coords)
+	if dims == 0 {
+		return InvalidBase32Value
+	}
+
+	var width = mortonWidth(dims)
+	var result = make([]byte, width)
+
+	// Start with the padding bits, so the digits line up with the end of the
+	// interleaved bits, just like Encode.
+	var digit = 0
+	var bits = uint(5*width - 32*dims)
+	var destIndex = 0
+
+	for shift := 31; shift >= 0; shift-- {
+		for _, coord := range coords {
+			digit = digit<<1 | int(coord>>uint(shift)&1)
+			bits++
+			if bits == 5 {
+				result[destIndex] = encodingValue[digit]
+				destIndex++
+				digit = 0
+				bits = 0
+			}
+		}
+	}
+
+	return Base32(result)
+}
+
+// DecodeMorton is the opposite of EncodeMorton. It splits a Morton key back
+// into `dims` coordinates.
+//
+// Like Decode, letters are case insensitive, 'O' is read as '0' and 'I' and
+// 'L' are read as '1'. An error is returned if `dims` is less than 1, if the
+// key isn't exactly as long as EncodeMorton makes it, or if it has an invalid
+// digit.
+func DecodeMorton(num Base32, dims int) ([]uint32, error) {
+	if dims < 1 {
+		return nil, mortonDimensions
+	}
+	if len(num) != mortonWidth(dims) {
+		return nil, mortonLength
+	}
+
+	var coords = make([]uint32, dims)
+	var padding = 5*len(num) - 32*dims
+	var bitIndex = 0 // Index of the next interleaved bit, not counting padding.
+
+	for i := 0; i < len(num); i++ {
+		val := digitValue(num[i])
+		if val == invalidDecodeValue {
+			return nil, decodeInvalidDigit
+		}
+
+		for shift := 4; shift >= 0; shift-- {
+			bit := val >> uint(shift) & 1
+			if padding > 0 {
+				padding--
+				if bit != 0 {
+					return nil, decodeInvalidDigit
+				}
+				continue
+			}
+			dim := bitIndex % dims
+			coords[dim] = coords[dim]<<1 | bit
+			bitIndex++
+		}
+	}
+
+	return coords, nil
+}
@@ -0,0 +1,92 @@
+package base32
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeMorton(t *testing.T) {
+	cases := []struct {
+		input    []uint32
+		expected Base32
+	}{
+		{[]uint32{90}, "000002T"},
+		{[]uint32{maxUint32Value}, "3ZZZZZZ"},
+		{[]uint32{0, 0}, "0000000000000"},
+		{[]uint32{1, 0}, "0000000000002"},
+		{[]uint32{0, 1}, "0000000000001"},
+		{[]uint32{3, 3}, "000000000000F"},
+		{[]uint32{maxUint32Value, 0}, "ANANANANANANA"},
+		{[]uint32{maxUint32Value, maxUint32Value}, "FZZZZZZZZZZZZ"},
+		{[]uint32{1, 1, 1}, "00000000000000000007"},
+		{[]uint32{}, InvalidBase32Value},
+	}
+
+	for _, c := range cases {
+		actual := EncodeMorton(c.input...)
+		if actual != c.expected {
+			t.Errorf("Expected EncodeMorton(%v) to be %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestDecodeMorton(t *testing.T) {
+	actual, err := DecodeMorton("ooooooooooooF", 2)
+	if err != nil || fmt.Sprint(actual) != "[3 3]" {
+		t.Errorf("Expected DecodeMorton(\"ooooooooooooF\", 2) to be [3 3], <nil>; got %v, %v.",
+			actual, err)
+	}
+
+	invalid := []struct {
+		input Base32
+		dims  int
+		err   error
+	}{
+		{"0", 0, mortonDimensions},
+		{"000000000000", 2, mortonLength},
+		{"00000000000000", 2, mortonLength},
+		{"000000000000U", 2, decodeInvalidDigit},
+		{"G000000000000", 2, decodeInvalidDigit}, // Padding bit is set.
+	}
+
+	for _, c := range invalid {
+		_, err := DecodeMorton(c.input, c.dims)
+		if err != c.err {
+			t.Errorf("Expected DecodeMorton(%q, %d) to return %v, got %v.",
+				c.input, c.dims, c.err, err)
+		}
+	}
+
+	for i := 0; i < 10000; i++ {
+		coords := make([]uint32, rand.Intn(4)+1)
+		for j := range coords {
+			coords[j] = rand.Uint32()
+		}
+
+		encoded := EncodeMorton(coords...)
+		decoded, err := DecodeMorton(encoded, len(coords))
+		if err != nil || fmt.Sprint(decoded) != fmt.Sprint(coords) {
+			t.Fatalf("Expected DecodeMorton(%q, %d) to be %v, <nil>; got %v, %v.",
+				encoded, len(coords), coords, decoded, err)
+		}
+	}
+}
+
+func BenchmarkEncodeMorton(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EncodeMorton(123123123, 456456456)
+	}
+}
+
+func ExampleEncodeMorton() {
+	// Points in the same quadrant share a prefix.
+	fmt.Println(EncodeMorton(0x80000000, 0x80000000))
+	fmt.Println(EncodeMorton(0x80000001, 0x80000000))
+	fmt.Println(EncodeMorton(0x00000001, 0x80000000))
+	// Output:
+	// C000000000000
+	// C000000000002
+	// 4000000000002
+}
@@ -0,0 +1,29 @@
+package base32
+
+// ParsePrefix decodes the Base32 number at the start of `s`, stopping at the
+// first character that isn't a Base32 digit, and returns the value and the
+// number of bytes it read:
+//
+//	ParsePrefix("2T/orders")   //=> 90, 2, nil
+//	ParsePrefix("ABC123.json") //=> 347472963, 6, nil
+//
+// Digits are read like Decode reads them: letters are case insensitive, 'O'
+// is read as '0', and 'I' and 'L' are read as '1'. Leading zeros are allowed.
+//
+// An error is returned if `s` is empty, if it doesn't start with a Base32
+// digit, or if the digits make a number too big for a uint64.
+func ParsePrefix(s string) (value uint64, consumed int, err error) {
+	if len(s) == 0 {
+		return 0, 0, decodeEmptyString
+	}
+
+	value, consumed, _, err = decodeDigits64(s, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	if consumed == 0 {
+		return 0, 0, decodeInvalidDigit
+	}
+
+	return value, consumed, nil
+}
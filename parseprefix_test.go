@@ -0,0 +1,70 @@
+package base32
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParsePrefix(t *testing.T) {
+	valid := []struct {
+		input    string
+		value    uint64
+		consumed int
+	}{
+		{"2T/orders", 90, 2},
+		{"ABC123.json", 347472963, 6},
+		{"2t", 90, 2},
+		{"oL-rest", 1, 2},
+		{"0000000000002T", 90, 14},
+		{"FZZZZZZZZZZZZ", maxUint64Value, 13},
+		{"CUT", 12, 1}, // U is not a digit.
+		{"Z測", 31, 1},
+	}
+
+	for _, c := range valid {
+		value, consumed, err := ParsePrefix(c.input)
+		if err != nil || value != c.value || consumed != c.consumed {
+			t.Errorf("Expected ParsePrefix(%q) to be %d, %d, <nil>; got %d, %d, %v.",
+				c.input, c.value, c.consumed, value, consumed, err)
+		}
+	}
+
+	invalid := []struct {
+		input string
+		err   error
+	}{
+		{"", decodeEmptyString},
+		{"/orders", decodeInvalidDigit},
+		{"U", decodeInvalidDigit},
+		{"G000000000000", decodeTooBig64},
+	}
+
+	for _, c := range invalid {
+		value, consumed, err := ParsePrefix(c.input)
+		if err != c.err || value != 0 || consumed != 0 {
+			t.Errorf("Expected ParsePrefix(%q) to be 0, 0, %v; got %d, %d, %v.",
+				c.input, c.err, value, consumed, err)
+		}
+	}
+}
+
+func BenchmarkParsePrefix(b *testing.B) {
+	input := "ABC123.json"
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = ParsePrefix(input)
+	}
+}
+
+func ExampleParsePrefix() {
+	input := "2T/orders"
+	value, consumed, err := ParsePrefix(input)
+	if err != nil {
+		fmt.Println("Unable to parse the prefix.")
+		return
+	}
+	fmt.Println(value, input[consumed:])
+	// Output:
+	// 90 /orders
+}
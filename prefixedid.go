@@ -0,0 +1,191 @@
+package base32
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var (
+	prefixUnknown    = errors.New("Unknown ID prefix")
+	prefixEmpty      = errors.New("An ID prefix can't be empty")
+	prefixRegistered = errors.New("The ID prefix is already registered")
+)
+
+// A PrefixedID is an ID written as a type prefix followed by a Base32 body and
+// an optional check symbol, like "inv_7K3M9XQ2". Use a PrefixRegistry to make
+// and parse them.
+type PrefixedID struct {
+	// Prefix is the registered prefix, including any separator, like "inv_".
+	Prefix string
+
+	// Value is the body, normalized by FromString.
+	Value Base32
+
+	// Check is the check symbol for Value, or InvalidCheckValue if the prefix
+	// doesn't use check symbols.
+	Check Check
+}
+
+// String returns the prefix, the body, and the check symbol if there is one.
+func (id PrefixedID) String() string {
+	if id.Check == InvalidCheckValue {
+		return id.Prefix + string(id.Value)
+	}
+	return id.Prefix + string(id.Value) + string(id.Check)
+}
+
+// An IDPart is the part of a PrefixedID that failed to parse.
+type IDPart int
+
+// The parts of a PrefixedID.
+const (
+	IDPrefix IDPart = iota
+	IDBody
+	IDCheck
+)
+
+func (part IDPart) String() string {
+	switch part {
+	case IDPrefix:
+		return "prefix"
+	case IDBody:
+		return "body"
+	case IDCheck:
+		return "check symbol"
+	}
+	return fmt.Sprintf("IDPart(%d)", int(part))
+}
+
+// A PrefixedIDError is returned by PrefixRegistry when an ID can't be made or
+// parsed. Part says which part of the ID is wrong, and Err says why.
+type PrefixedIDError struct {
+	Input string
+	Part  IDPart
+	Err   error
+}
+
+func (e *PrefixedIDError) Error() string {
+	return fmt.Sprintf("Invalid %s in ID %q: %v", e.Part, e.Input, e.Err)
+}
+
+func (e *PrefixedIDError) Unwrap() error {
+	return e.Err
+}
+
+// A PrefixRegistry holds the prefixes that are allowed in PrefixedIDs, and
+// whether each one uses check symbols:
+//
+//	var ids = base32.NewPrefixRegistry()
+//	ids.Register("inv_", true)
+//	ids.Register("cus_", false)
+//
+//	id, err := ids.Parse("cus_7k3m-9xq2") // id.Prefix == "cus_", id.Value == "7K3M9XQ2"
+//
+// A PrefixRegistry is safe for concurrent use.
+type PrefixRegistry struct {
+	mutex    sync.RWMutex
+	prefixes map[string]bool // Prefix => uses check symbols.
+}
+
+// NewPrefixRegistry returns an empty PrefixRegistry.
+func NewPrefixRegistry() *PrefixRegistry {
+	return &PrefixRegistry{prefixes: make(map[string]bool)}
+}
+
+// Register allows `prefix` in IDs. If `check` is true, IDs with that prefix
+// end with a check symbol. An error is returned if the prefix is empty or
+// already registered.
+//
+// The prefix should end with a separator, like "_", that isn't a Base32
+// digit. If one registered prefix starts with another, Parse uses the longest
+// one that matches.
+func (r *PrefixRegistry) Register(prefix string, check bool) error {
+	if prefix == "" {
+		return prefixEmpty
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, found := r.prefixes[prefix]; found {
+		return prefixRegistered
+	}
+	r.prefixes[prefix] = check
+	return nil
+}
+
+// New returns the PrefixedID with the given prefix and body, adding a check
+// symbol if the prefix uses them. The body is normalized with FromString, so
+// it may have hyphens and aliases.
+//
+// A *PrefixedIDError is returned if the prefix isn't registered or the body
+// is invalid.
+func (r *PrefixRegistry) New(prefix string, body string) (PrefixedID, error) {
+	r.mutex.RLock()
+	check, found := r.prefixes[prefix]
+	r.mutex.RUnlock()
+
+	if !found {
+		return PrefixedID{}, &PrefixedIDError{prefix + body, IDPrefix, prefixUnknown}
+	}
+
+	value, err := FromString(body)
+	if err != nil {
+		return PrefixedID{}, &PrefixedIDError{prefix + body, IDBody, err}
+	}
+
+	var id = PrefixedID{Prefix: prefix, Value: value, Check: InvalidCheckValue}
+	if check {
+		id.Check = checkOf(value)
+	}
+	return id, nil
+}
+
+// Parse reads a PrefixedID. The prefix must be registered, and must match
+// exactly. The body is normalized with FromString, and if the prefix uses
+// check symbols, the last character must be a matching check symbol.
+//
+// A *PrefixedIDError is returned if any part of the ID is wrong. Its Part is
+// IDPrefix if no registered prefix matches, IDBody if the body is empty or
+// has an invalid digit, and IDCheck if the check symbol is missing, invalid or
+// doesn't match.
+func (r *PrefixRegistry) Parse(input string) (PrefixedID, error) {
+	prefix, check, found := r.match(input)
+	if !found {
+		return PrefixedID{}, &PrefixedIDError{input, IDPrefix, prefixUnknown}
+	}
+
+	digits, symbol, err := splitCheck(input[len(prefix):], check)
+	switch {
+	case errors.Is(err, checkMissing), errors.Is(err, invalidCheckDigit), errors.Is(err, invalidCheckLength):
+		return PrefixedID{}, &PrefixedIDError{input, IDCheck, err}
+	case err != nil:
+		return PrefixedID{}, &PrefixedIDError{input, IDBody, err}
+	}
+
+	value, err := FromString(digits)
+	if err != nil {
+		return PrefixedID{}, &PrefixedIDError{input, IDBody, err}
+	}
+
+	if check && !checkMatches(value, symbol) {
+		return PrefixedID{}, &PrefixedIDError{input, IDCheck, checkNoMatch}
+	}
+
+	return PrefixedID{Prefix: prefix, Value: value, Check: symbol}, nil
+}
+
+// match returns the longest registered prefix of `input`.
+func (r *PrefixRegistry) match(input string) (prefix string, check bool, found bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for candidate, candidateCheck := range r.prefixes {
+		if len(candidate) > len(prefix) && strings.HasPrefix(input, candidate) {
+			prefix, check, found = candidate, candidateCheck, true
+		}
+	}
+	return prefix, check, found
+}
@@ -0,0 +1,142 @@
+package base32
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func testPrefixRegistry(t testing.TB) *PrefixRegistry {
+	var registry = NewPrefixRegistry()
+	for prefix, check := range map[string]bool{"inv_": true, "cus_": false, "cus_test_": false} {
+		if err := registry.Register(prefix, check); err != nil {
+			t.Fatalf("Expected Register(%q) to succeed, got %v.", prefix, err)
+		}
+	}
+	return registry
+}
+
+func TestPrefixRegistry_Register(t *testing.T) {
+	registry := testPrefixRegistry(t)
+	if err := registry.Register("inv_", false); err != prefixRegistered {
+		t.Errorf("Expected registering a prefix twice to fail, got %v.", err)
+	}
+	if err := registry.Register("", false); err != prefixEmpty {
+		t.Errorf("Expected registering an empty prefix to fail, got %v.", err)
+	}
+}
+
+func TestPrefixRegistry_Parse(t *testing.T) {
+	registry := testPrefixRegistry(t)
+
+	cases := []struct {
+		input    string
+		expected PrefixedID
+	}{
+		{"inv_2TG", PrefixedID{"inv_", "2T", 'G'}},
+		{"inv_00-2tg", PrefixedID{"inv_", "2T", 'G'}},
+		{"inv_ZZZZZZZZZZZZZZZZ~", PrefixedID{"inv_", "ZZZZZZZZZZZZZZZZ", '~'}},
+		{"cus_7k3m-9xq2", PrefixedID{"cus_", "7K3M9XQ2", InvalidCheckValue}},
+		{"cus_test_2T", PrefixedID{"cus_test_", "2T", InvalidCheckValue}},
+	}
+	for _, c := range cases {
+		actual, err := registry.Parse(c.input)
+		if err != nil {
+			t.Errorf("Expected Parse(%q) to succeed, got %v.", c.input, err)
+		}
+		if actual != c.expected {
+			t.Errorf("Expected Parse(%q) to be %+v, got %+v.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestPrefixRegistry_Parse_Errors(t *testing.T) {
+	registry := testPrefixRegistry(t)
+
+	cases := []struct {
+		input string
+		part  IDPart
+		err   error
+	}{
+		{"ord_2TG", IDPrefix, prefixUnknown},
+		{"INV_2TG", IDPrefix, prefixUnknown},
+		{"2TG", IDPrefix, prefixUnknown},
+		{"cus_", IDBody, decodeEmptyString},
+		{"cus_2U", IDBody, decodeInvalidDigit},
+		{"cus_2T!", IDBody, decodeInvalidDigit},
+		{"inv_2U-G", IDCheck, checkMissing},
+		{"inv_G", IDCheck, checkMissing},
+		{"inv_2T%", IDCheck, invalidCheckDigit},
+		{"inv_2TH", IDCheck, checkNoMatch},
+	}
+	for _, c := range cases {
+		_, err := registry.Parse(c.input)
+
+		var idErr *PrefixedIDError
+		if !errors.As(err, &idErr) {
+			t.Errorf("Expected Parse(%q) to return a *PrefixedIDError, got %v.", c.input, err)
+			continue
+		}
+		if idErr.Part != c.part || !errors.Is(err, c.err) || idErr.Input != c.input {
+			t.Errorf("Expected Parse(%q) to fail in the %v with %v, got %v.", c.input, c.part, c.err, err)
+		}
+	}
+}
+
+func TestPrefixRegistry_New(t *testing.T) {
+	registry := testPrefixRegistry(t)
+
+	id, err := registry.New("inv_", "00-2t")
+	if err != nil || id.String() != "inv_2TG" {
+		t.Errorf("Expected New to give %q, got %q (%v).", "inv_2TG", id, err)
+	}
+
+	id, err = registry.New("cus_", "7k3m9xq2")
+	if err != nil || id.String() != "cus_7K3M9XQ2" {
+		t.Errorf("Expected New to give %q, got %q (%v).", "cus_7K3M9XQ2", id, err)
+	}
+
+	var idErr *PrefixedIDError
+	if _, err := registry.New("ord_", "2T"); !errors.As(err, &idErr) || idErr.Part != IDPrefix {
+		t.Errorf("Expected New to reject an unknown prefix, got %v.", err)
+	}
+	if _, err := registry.New("inv_", "2U"); !errors.As(err, &idErr) || idErr.Part != IDBody {
+		t.Errorf("Expected New to reject an invalid body, got %v.", err)
+	}
+}
+
+func TestPrefixedIDError_Error(t *testing.T) {
+	err := &PrefixedIDError{"inv_2TH", IDCheck, checkNoMatch}
+	expected := `Invalid check symbol in ID "inv_2TH": The check symbol does not match the value`
+	if err.Error() != expected {
+		t.Errorf("Expected Error() to be %q, got %q.", expected, err.Error())
+	}
+	if actual := IDPart(7).String(); actual != "IDPart(7)" {
+		t.Errorf("Expected IDPart(7).String() to be %q, got %q.", "IDPart(7)", actual)
+	}
+}
+
+func BenchmarkPrefixRegistry_Parse(b *testing.B) {
+	b.ReportAllocs()
+	registry := testPrefixRegistry(b)
+	for i := 0; i < b.N; i++ {
+		registry.Parse("inv_1A2B3C2")
+	}
+}
+
+func ExamplePrefixRegistry() {
+	var ids = NewPrefixRegistry()
+	ids.Register("inv_", true)
+	ids.Register("cus_", false)
+
+	id, _ := ids.New("inv_", "7k3m-9xq")
+	fmt.Println(id)
+
+	_, err := ids.Parse("inv_7K3M9XQ0")
+	if idErr, ok := err.(*PrefixedIDError); ok {
+		fmt.Println(idErr.Part, "failed:", idErr.Err)
+	}
+	// Output:
+	// inv_7K3M9XQ9
+	// check symbol failed: The check symbol does not match the value
+}
@@ -0,0 +1,151 @@
+package base32
+
+import (
+	"iter"
+)
+
+// Next returns the Base32 number that comes after `num`. It works directly on
+// the digits, carrying through 'Z' to '0' as needed, so it works on numbers of
+// any length. Leading zeros are trimmed from the result:
+//
+//	Base32("2T").Next()  //=> "2V"
+//	Base32("ZZ").Next()  //=> "100"
+//	Base32("0Z").Next()  //=> "10"
+//
+// The common input errors are handled like Decode does. InvalidBase32Value is
+// returned if `num` is not valid. See NextPadded to keep leading zeros.
+func (num Base32) Next() Base32 {
+	digits, carry := stepDigits(num, 1)
+	if digits == nil {
+		return InvalidBase32Value
+	}
+	if carry {
+		digits = append([]byte{'1'}, digits...)
+	}
+	return trimDigits(digits)
+}
+
+// Prev returns the Base32 number that comes before `num`. See Next.
+// InvalidBase32Value is returned if `num` is zero or not valid.
+func (num Base32) Prev() Base32 {
+	digits, borrow := stepDigits(num, -1)
+	if digits == nil || borrow {
+		return InvalidBase32Value
+	}
+	return trimDigits(digits)
+}
+
+// NextPadded is like Next, but keeps the same number of digits as `num`,
+// including leading zeros:
+//
+//	Base32("00ZZ").NextPadded() //=> "0100"
+//
+// InvalidBase32Value is returned if the result needs more digits than `num`
+// has, or if `num` is not valid.
+func (num Base32) NextPadded() Base32 {
+	digits, carry := stepDigits(num, 1)
+	if digits == nil || carry {
+		return InvalidBase32Value
+	}
+	return Base32(digits)
+}
+
+// PrevPadded is like Prev, but keeps the same number of digits as `num`,
+// including leading zeros. InvalidBase32Value is returned if `num` is zero or
+// not valid.
+func (num Base32) PrevPadded() Base32 {
+	digits, borrow := stepDigits(num, -1)
+	if digits == nil || borrow {
+		return InvalidBase32Value
+	}
+	return Base32(digits)
+}
+
+// Range returns an iterator over the Base32 numbers from `lo` to `hi`,
+// inclusive, in increasing order. Only the first value is encoded; the rest are
+// made with Next, without decoding or encoding.
+//
+//	for code := range Range(30, 33) {
+//		fmt.Println(code) // Y, Z, 10, 11
+//	}
+func Range(lo, hi uint64) iter.Seq[Base32] {
+	return rangeSeq(lo, hi, 0)
+}
+
+// RangePadded is like Range, but every value is padded with zeros to at least
+// `width` digits, as Base32.Pad does. Values keep the same width until they
+// need more digits.
+func RangePadded(lo, hi uint64, width uint8) iter.Seq[Base32] {
+	return rangeSeq(lo, hi, width)
+}
+
+// rangeSeq is the shared implementation of Range and RangePadded. A width of 0
+// means no padding.
+func rangeSeq(lo, hi uint64, width uint8) iter.Seq[Base32] {
+	return func(yield func(Base32) bool) {
+		if lo > hi {
+			return
+		}
+
+		var buffer [SortableWidth64]byte
+		encodeFixedWidth(buffer[:], lo)
+		var num = Base32(trimDigits(buffer[:]).Pad(width))
+
+		for i := lo; ; i++ {
+			if !yield(num) {
+				return
+			}
+			if i == hi {
+				return
+			}
+			if next := num.NextPadded(); next != InvalidBase32Value {
+				num = next
+			} else {
+				num = num.Next()
+			}
+		}
+	}
+}
+
+// stepDigits adds `delta` (+1 or -1) to the digits of `num`, keeping the same
+// number of digits. It returns the new digits, and true if the result carried
+// out of (or borrowed past) the most significant digit. It returns nil if
+// `num` is empty or has an invalid digit.
+func stepDigits(num Base32, delta int) ([]byte, bool) {
+	if len(num) == 0 {
+		return nil, false
+	}
+
+	var digits = make([]byte, len(num))
+	for i := 0; i < len(num); i++ {
+		val := digitValue(num[i])
+		if val == invalidDecodeValue {
+			return nil, false
+		}
+		digits[i] = encodingValue[val]
+	}
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		val := int(decodingValue[digits[i]]) + delta
+		switch {
+		case val > 31:
+			digits[i] = '0'
+		case val < 0:
+			digits[i] = 'Z'
+		default:
+			digits[i] = encodingValue[val]
+			return digits, false
+		}
+	}
+
+	return digits, true
+}
+
+// trimDigits removes leading zeros from `digits`, leaving at least one digit.
+func trimDigits(digits []byte) Base32 {
+	var i = 0
+	for i < len(digits)-1 && digits[i] == '0' {
+		i++
+	}
+	return Base32(digits[i:])
+}
@@ -0,0 +1,144 @@
+package base32
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestBase32_NextPrev(t *testing.T) {
+	cases := []struct {
+		input      Base32
+		next, prev Base32
+	}{
+		{"0", "1", InvalidBase32Value},
+		{"1", "2", "0"},
+		{"2T", "2V", "2S"},
+		{"Z", "10", "Y"},
+		{"10", "11", "Z"},
+		{"ZZ", "100", "ZY"},
+		{"0Z", "10", "Y"},
+		{"oL", "2", "0"},
+		{"zz", "100", "ZY"},
+		{"ZZZZZZZZZZZZZZZZZZZZ", "100000000000000000000", "ZZZZZZZZZZZZZZZZZZZY"},
+		{"", InvalidBase32Value, InvalidBase32Value},
+		{"U", InvalidBase32Value, InvalidBase32Value},
+	}
+
+	for _, c := range cases {
+		if actual := c.input.Next(); actual != c.next {
+			t.Errorf("Expected %q.Next() to be %q, got %q.", c.input, c.next, actual)
+		}
+		if actual := c.input.Prev(); actual != c.prev {
+			t.Errorf("Expected %q.Prev() to be %q, got %q.", c.input, c.prev, actual)
+		}
+	}
+
+	for i := 0; i < 10000; i++ {
+		input := rand.Uint32() >> 1
+		if actual := Encode(input).Next(); actual != Encode(input+1) {
+			t.Fatalf("Expected %q.Next() to be %q, got %q.", Encode(input), Encode(input+1), actual)
+		}
+		if actual := Encode(input + 1).Prev(); actual != Encode(input) {
+			t.Fatalf("Expected %q.Prev() to be %q, got %q.", Encode(input+1), Encode(input), actual)
+		}
+	}
+}
+
+func TestBase32_NextPrevPadded(t *testing.T) {
+	cases := []struct {
+		input      Base32
+		next, prev Base32
+	}{
+		{"0000", "0001", InvalidBase32Value},
+		{"00ZZ", "0100", "00ZY"},
+		{"0100", "0101", "00ZZ"},
+		{"ZZ", InvalidBase32Value, "ZY"},
+		{"oo", "01", InvalidBase32Value},
+	}
+
+	for _, c := range cases {
+		if actual := c.input.NextPadded(); actual != c.next {
+			t.Errorf("Expected %q.NextPadded() to be %q, got %q.", c.input, c.next, actual)
+		}
+		if actual := c.input.PrevPadded(); actual != c.prev {
+			t.Errorf("Expected %q.PrevPadded() to be %q, got %q.", c.input, c.prev, actual)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	cases := []struct {
+		lo, hi   uint64
+		width    uint8
+		expected string
+	}{
+		{30, 33, 0, "[Y Z 10 11]"},
+		{0, 2, 0, "[0 1 2]"},
+		{5, 5, 0, "[5]"},
+		{5, 4, 0, "[]"},
+		{30, 33, 3, "[00Y 00Z 010 011]"},
+		{1022, 1025, 2, "[ZY ZZ 100 101]"},
+		{maxUint64Value - 1, maxUint64Value, 0, "[FZZZZZZZZZZZY FZZZZZZZZZZZZ]"},
+	}
+
+	for _, c := range cases {
+		var actual []Base32
+		for num := range RangePadded(c.lo, c.hi, c.width) {
+			actual = append(actual, num)
+		}
+		if fmt.Sprint(actual) != c.expected {
+			t.Errorf("Expected RangePadded(%d, %d, %d) to be %s, got %v.",
+				c.lo, c.hi, c.width, c.expected, actual)
+		}
+	}
+
+	// Every value must match Encode.
+	var i uint32 = 1000
+	for num := range Range(1000, 40000) {
+		if num != Encode(i) {
+			t.Fatalf("Expected Range value %d to be %q, got %q.", i, Encode(i), num)
+		}
+		i++
+	}
+
+	// Stopping early.
+	count := 0
+	for range Range(0, maxUint64Value) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("Expected to stop Range after 3 values, got %d.", count)
+	}
+}
+
+func BenchmarkBase32_Next(b *testing.B) {
+	base32 := Base32("3ZZZZZY")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = base32.Next()
+	}
+}
+
+func ExampleRange() {
+	for code := range Range(30, 33) {
+		fmt.Println(code)
+	}
+	// Output:
+	// Y
+	// Z
+	// 10
+	// 11
+}
+
+func ExampleBase32_NextPadded() {
+	fmt.Println(Base32("00ZZ").Next())
+	fmt.Println(Base32("00ZZ").NextPadded())
+	// Output:
+	// 100
+	// 0100
+}
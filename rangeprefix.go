@@ -0,0 +1,71 @@
+package base32
+
+import (
+	"strings"
+)
+
+// RangePrefixes returns the smallest set of Base32 prefixes that covers exactly
+// the numbers from `lo` to `hi`, inclusive, when every number is padded with
+// zeros to `width` digits (see Base32.Pad). This turns a numeric range into
+// prefix searches that can use a text index:
+//
+//	RangePrefixes(32, 95, 3) //=> ["01", "02"]
+//	// WHERE code LIKE '01%' OR code LIKE '02%'
+//
+// The prefixes are returned in increasing order. A prefix of "" matches every
+// value. Numbers too big to be written in `width` digits are left out, and nil
+// is returned if `lo` > `hi` or `width` < 1.
+func RangePrefixes(lo, hi uint64, width int) []string {
+	if width < 1 || lo > hi {
+		return nil
+	}
+
+	// A uint64 never needs more than 13 digits. Any extra digits are always
+	// zero, so they are added back to the front of every prefix at the end.
+	var extraZeros = ""
+	if width > SortableWidth64 {
+		extraZeros = strings.Repeat("0", width-SortableWidth64)
+		width = SortableWidth64
+	}
+
+	// Every uint64 fits in 13 digits, so only narrower widths need clamping.
+	if width < SortableWidth64 {
+		max := uint64(1)<<uint(5*width) - 1
+		if lo > max {
+			return nil
+		}
+		if hi > max {
+			hi = max
+		}
+	}
+
+	var buffer [SortableWidth64]byte
+	var result []string
+
+	for {
+		// Find the biggest aligned block of 32^k numbers that starts at lo and
+		// doesn't go past hi. Every number in the block shares the same
+		// (width - k)-digit prefix. At 13 digits, the block is never the full
+		// width, since 13-digit values starting with G to Z don't fit in a
+		// uint64.
+		var k uint
+		for k+1 <= uint(width) && k+1 < SortableWidth64 {
+			size := uint64(1) << (5 * (k + 1))
+			if lo&(size-1) != 0 || hi-lo < size-1 {
+				break
+			}
+			k++
+		}
+
+		encodeFixedWidth(buffer[:width], lo)
+		result = append(result, extraZeros+string(buffer[:width-int(k)]))
+
+		size := uint64(1) << (5 * k)
+		if hi-lo < size {
+			break
+		}
+		lo += size
+	}
+
+	return result
+}
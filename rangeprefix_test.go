@@ -0,0 +1,80 @@
+package base32
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestRangePrefixes(t *testing.T) {
+	cases := []struct {
+		lo, hi   uint64
+		width    int
+		expected []string
+	}{
+		{32, 95, 3, []string{"01", "02"}},
+		{90, 90, 3, []string{"02T"}},
+		{0, 31, 1, []string{""}},
+		{0, 1023, 2, []string{""}},
+		{0, 31, 2, []string{"0"}},
+		{30, 33, 2, []string{"0Y", "0Z", "10", "11"}},
+		{0, 40, 2, []string{"0", "10", "11", "12", "13", "14", "15", "16", "17", "18"}},
+		{0, maxUint64Value, 13, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "A", "B", "C", "D", "E", "F"}},
+		{0, maxUint64Value, 15, []string{"000", "001", "002", "003", "004", "005", "006", "007", "008", "009", "00A", "00B", "00C", "00D", "00E", "00F"}},
+		{0, 31, 14, []string{"0000000000000"}},
+		{30, 2000, 1, []string{"Y", "Z"}},
+		{5, 3, 2, nil},
+		{1000, 2000, 1, nil},
+		{0, 1, 0, nil},
+	}
+
+	for _, c := range cases {
+		actual := RangePrefixes(c.lo, c.hi, c.width)
+		if (actual == nil) != (c.expected == nil) || fmt.Sprintf("%q", actual) != fmt.Sprintf("%q", c.expected) {
+			t.Errorf("Expected RangePrefixes(%d, %d, %d) to be %q, got %q.",
+				c.lo, c.hi, c.width, c.expected, actual)
+		}
+	}
+
+	// Check the prefixes against every value in and around some random
+	// ranges.
+	for i := 0; i < 300; i++ {
+		const width = 3
+		lo := uint64(rand.Intn(32768))
+		hi := lo + uint64(rand.Intn(2000))
+		prefixes := RangePrefixes(lo, hi, width)
+
+		for v := lo - lo%1024; v < hi+1024 && v < 32768; v++ {
+			padded := string(Encode(uint32(v)).Pad(width))
+			matches := 0
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(padded, prefix) {
+					matches++
+				}
+			}
+			if inRange := v >= lo && v <= hi; (inRange && matches != 1) || (!inRange && matches != 0) {
+				t.Fatalf("Expected %q to match RangePrefixes(%d, %d, %d) = %q only if in range, got %d matches.",
+					padded, lo, hi, width, prefixes, matches)
+			}
+		}
+	}
+}
+
+func BenchmarkRangePrefixes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = RangePrefixes(123123, 987987987, 7)
+	}
+}
+
+func ExampleRangePrefixes() {
+	for _, prefix := range RangePrefixes(30, 95, 3) {
+		fmt.Printf("code LIKE '%s%%'\n", prefix)
+	}
+	// Output:
+	// code LIKE '00Y%'
+	// code LIKE '00Z%'
+	// code LIKE '01%'
+	// code LIKE '02%'
+}
@@ -0,0 +1,170 @@
+package base32
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+)
+
+// A Redactor masks Base32 IDs in text, such as log lines, leaving only the last
+// few digits so they can still be told apart:
+//
+//	r := &Redactor{Scanner: Scanner{MinDigits: 6, Check: true}, Keep: 2}
+//	r.Redact("order 1A2B3C2 shipped") //=> "order ####3C# shipped"
+//
+// The Scanner decides what counts as an ID. Requiring a check symbol (see
+// GenerateCheck) keeps ordinary words from being masked.
+//
+// A Redactor is safe for concurrent use as long as it isn't changed.
+type Redactor struct {
+	Scanner Scanner
+
+	// Keep is the number of digits to leave at the end of each ID. The check
+	// symbol, if any, is always masked.
+	Keep int
+
+	// Mask replaces the masked digits. Zero means '#'.
+	Mask byte
+}
+
+// Redact returns `text` with every ID found by the Scanner masked. Hyphens
+// within an ID are left alone.
+func (r *Redactor) Redact(text string) string {
+	var matches = r.Scanner.FindAll(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	var result = []byte(text)
+	for _, match := range matches {
+		end := match.End
+		if r.Scanner.Check {
+			result[end-1] = r.mask()
+			end--
+		}
+		r.maskDigits(result[match.Start:end])
+	}
+
+	return string(result)
+}
+
+// mask returns the byte that replaces masked digits.
+func (r *Redactor) mask() byte {
+	if r.Mask == 0 {
+		return '#'
+	}
+	return r.Mask
+}
+
+// maskDigits masks `digits` in place, right to left, skipping hyphens and the
+// last Keep digits.
+func (r *Redactor) maskDigits(digits []byte) {
+	kept := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		if digits[i] == '-' {
+			continue
+		}
+		if kept < r.Keep {
+			kept++
+			continue
+		}
+		digits[i] = r.mask()
+	}
+}
+
+// A RedactHandler is a slog.Handler that masks IDs in log messages and string
+// attributes, including attributes in groups, before passing the record on to
+// another handler. Attributes holding a Base32 value are always masked,
+// including the decoded number and check symbol that Base32.LogValue adds.
+type RedactHandler struct {
+	next     slog.Handler
+	redactor *Redactor
+}
+
+// NewRedactHandler returns a RedactHandler that uses `redactor` and then passes
+// records on to `next`.
+func NewRedactHandler(next slog.Handler, redactor *Redactor) *RedactHandler {
+	return &RedactHandler{next: next, redactor: redactor}
+}
+
+// Enabled implements slog.Handler.
+func (h *RedactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *RedactHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, h.redactor.Redact(record.Message), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redactAttr(attr))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *RedactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var redacted = make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redacted[i] = h.redactAttr(attr)
+	}
+	return &RedactHandler{next: h.next.WithAttrs(redacted), redactor: h.redactor}
+}
+
+// WithGroup implements slog.Handler.
+func (h *RedactHandler) WithGroup(name string) slog.Handler {
+	return &RedactHandler{next: h.next.WithGroup(name), redactor: h.redactor}
+}
+
+// redactAttr masks IDs in a string attribute, or in the string attributes of a
+// group. Base32 values are always masked; see redactBase32.
+func (h *RedactHandler) redactAttr(attr slog.Attr) slog.Attr {
+	if attr.Value.Kind() == slog.KindLogValuer {
+		if num, ok := attr.Value.LogValuer().(Base32); ok {
+			attr.Value = h.redactBase32(num)
+			return attr
+		}
+	}
+
+	attr.Value = attr.Value.Resolve()
+	switch attr.Value.Kind() {
+	case slog.KindString:
+		attr.Value = slog.StringValue(h.redactor.Redact(attr.Value.String()))
+	case slog.KindGroup:
+		group := attr.Value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, member := range group {
+			redacted[i] = h.redactAttr(member)
+		}
+		attr.Value = slog.GroupValue(redacted...)
+	}
+	return attr
+}
+
+// redactBase32 returns the masked slog.Value of a Base32 value. A Base32 value
+// is known to be an ID, so it is masked whether or not the Scanner would match
+// it. The digits are masked like Redact masks them, and the decoded number and
+// check symbol, which would give the ID away, are masked completely.
+func (h *RedactHandler) redactBase32(num Base32) slog.Value {
+	value := num.LogValue()
+	if value.Kind() != slog.KindGroup {
+		// An invalid value logged as a string.
+		return slog.StringValue(h.redactor.Redact(value.String()))
+	}
+
+	group := value.Group()
+	redacted := make([]slog.Attr, len(group))
+	for i, attr := range group {
+		switch attr.Key {
+		case "base32", "raw":
+			digits := []byte(attr.Value.String())
+			h.redactor.maskDigits(digits)
+			attr.Value = slog.StringValue(string(digits))
+		case "value", "check":
+			masked := bytes.Repeat([]byte{h.redactor.mask()}, len(attr.Value.String()))
+			attr.Value = slog.StringValue(string(masked))
+		}
+		redacted[i] = attr
+	}
+	return slog.GroupValue(redacted...)
+}
@@ -0,0 +1,146 @@
+package base32
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedactor_Redact(t *testing.T) {
+	cases := []struct {
+		redactor Redactor
+		input    string
+		expected string
+	}{
+		{
+			Redactor{Scanner: Scanner{MinDigits: 6, Check: true}, Keep: 2},
+			"order 1A2B3C2 shipped",
+			"order ####3C# shipped",
+		},
+		{
+			Redactor{Scanner: Scanner{MinDigits: 6, Check: true}, Keep: 2},
+			"order 1A2B3C3 shipped", // Wrong check symbol.
+			"order 1A2B3C3 shipped",
+		},
+		{
+			Redactor{Scanner: Scanner{MinDigits: 6, Check: true}, Keep: 3, Mask: 'x'},
+			"codes A1B-2C3-DQ and 3nDDDKu",
+			"codes xxx-xC3-Dx and xxxDDKx",
+		},
+		{
+			Redactor{Scanner: Scanner{MinDigits: 2, MaxDigits: 3}},
+			"a 2T 8GT ABCD",
+			"a ## ### ABCD",
+		},
+		{
+			Redactor{Scanner: Scanner{MinDigits: 2}, Keep: 10},
+			"a 2T 8GT",
+			"a 2T 8GT",
+		},
+	}
+
+	for _, c := range cases {
+		actual := c.redactor.Redact(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected Redact(%q) to be %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestRedactHandler(t *testing.T) {
+	var buffer bytes.Buffer
+	redactor := &Redactor{Scanner: Scanner{MinDigits: 6, Check: true}, Keep: 2}
+	options := &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}
+	logger := slog.New(NewRedactHandler(slog.NewTextHandler(&buffer, options), redactor))
+
+	logger.With("customer", "3NDDDKU").Info("order 1A2B3C2 shipped",
+		"order", "1A2B3C2",
+		"count", 1,
+		slog.Group("coupon", "code", "A1B-2C3-DQ", "note", "not a code"),
+	)
+	logger.WithGroup("req").Warn("plain message", "id", "1A2B3C2")
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	expected := []string{
+		`level=INFO msg="order ####3C# shipped" customer=####DK# order=####3C# count=1 coupon.code=###-##3-D# coupon.note="not a code"`,
+		`level=WARN msg="plain message" req.id=####3C#`,
+	}
+
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d log lines, got %q.", len(expected), lines)
+	}
+	for i := range lines {
+		if lines[i] != expected[i] {
+			t.Errorf("Expected log line %q, got %q.", expected[i], lines[i])
+		}
+	}
+}
+
+func TestRedactHandler_Base32(t *testing.T) {
+	var buffer bytes.Buffer
+	options := &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if (attr.Key == slog.TimeKey || attr.Key == slog.LevelKey) && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}
+
+	for _, check := range []bool{false, true} {
+		buffer.Reset()
+		redactor := &Redactor{Scanner: Scanner{MinDigits: 6, Check: check}, Keep: 2}
+		logger := slog.New(NewRedactHandler(slog.NewTextHandler(&buffer, options), redactor))
+
+		logger.With("customer", Base32("3NDDDK")).Info("m",
+			"order", Base32("1A2B3C"),
+			"short", Base32("2T"),
+			slog.Group("g", "order", Base32("00-1a2b3c")),
+		)
+
+		expected := "msg=m customer.base32=####DK customer.value=######### customer.check=#" +
+			" order.base32=####3C order.value=######## order.check=#" +
+			" short.base32=2T short.value=## short.check=#" +
+			" g.order.base32=####3C g.order.value=######## g.order.check=#\n"
+		if buffer.String() != expected {
+			t.Errorf("Expected log line %q with Check %v, got %q.", expected, check, buffer.String())
+		}
+	}
+
+	SetLogInvalidAsError(true)
+	defer SetLogInvalidAsError(false)
+
+	buffer.Reset()
+	redactor := &Redactor{Keep: 2}
+	slog.New(NewRedactHandler(slog.NewTextHandler(&buffer, options), redactor)).Info("m", "id", Base32("1A2B3U"))
+	expected := `msg=m id.error="Invalid Base32 digit" id.raw=####3U` + "\n"
+	if buffer.String() != expected {
+		t.Errorf("Expected log line %q, got %q.", expected, buffer.String())
+	}
+}
+
+func BenchmarkRedactor_Redact(b *testing.B) {
+	redactor := &Redactor{Scanner: Scanner{MinDigits: 6, Check: true}, Keep: 2}
+	input := "Hello, your order 1A2B3C2 has shipped. Your coupon is A1B-2C3-DQ."
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = redactor.Redact(input)
+	}
+}
+
+func ExampleRedactor() {
+	redactor := &Redactor{Scanner: Scanner{MinDigits: 6, Check: true}, Keep: 2}
+	fmt.Println(redactor.Redact("Your order 1A2B3C2 has shipped."))
+	// Output:
+	// Your order ####3C# has shipped.
+}
@@ -0,0 +1,189 @@
+package base32
+
+// A Scanner finds Base32 IDs, such as order codes, in free-form text.
+//
+// A candidate is a run of ASCII letters and digits, possibly with single
+// hyphens between them, that isn't touching any other letters or digits. When
+// Check is set, the last character of the run is a check symbol, which may be
+// one of *, ~, $ or = as well as a letter or digit. A candidate is a match if
+// it normalizes with FromString, has the right number of digits, and, when
+// Check is set, has a matching check symbol (see GenerateCheck).
+//
+// The zero value matches every Base32 number of any length, without a check
+// symbol.
+type Scanner struct {
+	// MinDigits and MaxDigits limit the number of digits in a match, not
+	// counting hyphens or the check symbol. Zero means no limit.
+	MinDigits, MaxDigits int
+
+	// Check requires every match to end with a valid check symbol.
+	Check bool
+}
+
+// A Match is a Base32 ID found by a Scanner.
+type Match struct {
+	// Start and End are the byte offsets of the match in the text, so the
+	// raw match is text[Start:End].
+	Start, End int
+
+	// Value is the ID normalized by FromString, without the check symbol.
+	Value Base32
+
+	// Check is the normalized check symbol, or InvalidCheckValue if the
+	// Scanner doesn't use check symbols.
+	Check Check
+}
+
+// FindAll returns every match in `text`, in order.
+func (s *Scanner) FindAll(text string) []Match {
+	var matches []Match
+	var offset = 0
+	for {
+		match, found, advance := s.scan(text[offset:], true)
+		if !found {
+			return matches
+		}
+		match.Start += offset
+		match.End += offset
+		matches = append(matches, match)
+		offset += advance
+	}
+}
+
+// Split is a bufio.SplitFunc that returns the raw text of each match, and
+// skips everything else:
+//
+//	scanner := bufio.NewScanner(reader)
+//	scanner.Split((&base32.Scanner{MinDigits: 6, Check: true}).Split)
+//
+// Use FindAll to get byte offsets and normalized values.
+func (s *Scanner) Split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	match, found, advance := s.scan(string(data), atEOF)
+	if !found {
+		return advance, nil, nil
+	}
+	return advance, data[match.Start:match.End], nil
+}
+
+// scan finds the first match in `text`. It returns the match, true, and the
+// offset just past the match. If there is no match, it returns false and the
+// offset of the first byte that might still be part of a match if there were
+// more text, which is len(text) when `atEOF` is true.
+func (s *Scanner) scan(text string, atEOF bool) (match Match, found bool, advance int) {
+	var i = 0
+	for i < len(text) {
+		if !isAlphanumeric(text[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		end, complete := s.runEnd(text, start, atEOF)
+		if !complete {
+			return Match{}, false, start
+		}
+
+		if match, ok := s.match(text, start, end); ok {
+			return match, true, end
+		}
+
+		// Skip the rest of the run, including any letters or digits stuck
+		// to the end of it.
+		i = end
+		for i < len(text) && isAlphanumeric(text[i]) {
+			i++
+		}
+	}
+	return Match{}, false, len(text)
+}
+
+// runEnd returns the end of the candidate run starting at `start`. It returns
+// false if the run might continue past the end of `text`.
+func (s *Scanner) runEnd(text string, start int, atEOF bool) (int, bool) {
+	var j = start
+	for j < len(text) {
+		if isAlphanumeric(text[j]) {
+			j++
+			continue
+		}
+		if text[j] == '-' && j+1 < len(text) && isAlphanumeric(text[j+1]) {
+			j += 2
+			continue
+		}
+		break
+	}
+
+	// A run at the end of the text, or followed by a hyphen or a possible
+	// check symbol at the end of the text, might keep going.
+	if !atEOF && (j == len(text) || (j+1 == len(text) && (text[j] == '-' || isCheckSymbol(text[j])))) {
+		return j, false
+	}
+
+	if s.Check && j < len(text) && isCheckSymbol(text[j]) {
+		j++
+		if !atEOF && j == len(text) {
+			return j, false
+		}
+	}
+
+	return j, true
+}
+
+// match checks the candidate text[start:end].
+func (s *Scanner) match(text string, start, end int) (Match, bool) {
+
+	// A check symbol stuck to more letters or digits isn't a check symbol.
+	if end < len(text) && isAlphanumeric(text[end]) {
+		return Match{}, false
+	}
+
+	var digits = text[start:end]
+	var check = InvalidCheckValue
+	if s.Check {
+		if len(digits) < 2 || digits[len(digits)-2] == '-' {
+			return Match{}, false
+		}
+		var err error
+		check, err = CheckFromString(digits[len(digits)-1:])
+		if err != nil {
+			return Match{}, false
+		}
+		digits = digits[:len(digits)-1]
+	}
+
+	var count = 0
+	for i := 0; i < len(digits); i++ {
+		if digits[i] != '-' {
+			count++
+		}
+	}
+	if count < s.MinDigits || (s.MaxDigits > 0 && count > s.MaxDigits) {
+		return Match{}, false
+	}
+
+	value, err := FromString(digits)
+	if err != nil {
+		return Match{}, false
+	}
+
+	if s.Check && !checkMatches(value, check) {
+		return Match{}, false
+	}
+
+	return Match{Start: start, End: end, Value: value, Check: check}, true
+}
+
+// checkMatches is like Base32.IsValid, but works for numbers of any length.
+func checkMatches(num Base32, check Check) bool {
+	return check == checkOf(num)
+}
+
+// isAlphanumeric returns true for ASCII letters and digits.
+func isAlphanumeric(char byte) bool {
+	return (char >= '0' && char <= '9') || (char >= 'A' && char <= 'Z') || (char >= 'a' && char <= 'z')
+}
+
+// isCheckSymbol returns true for the check symbols that aren't letters.
+func isCheckSymbol(char byte) bool {
+	return char == '*' || char == '~' || char == '$' || char == '='
+}
@@ -0,0 +1,87 @@
+package base32
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestScanner_FindAll(t *testing.T) {
+	cases := []struct {
+		scanner  Scanner
+		input    string
+		expected string
+	}{
+		{Scanner{}, "order 2T shipped", "[RDER 2T SH1PPED]"}, // Plain words match, too.
+		{Scanner{MinDigits: 2, MaxDigits: 3}, "a 2T 8GT ABCD", "[2T 8GT]"},
+		{Scanner{MinDigits: 2}, "cut: 2t, 0o-8gt; ABC-DEF.", "[2T 8GT ABCDEF]"},
+		{Scanner{MinDigits: 2}, "CUT 2T-", "[2T]"},
+		{Scanner{Check: true}, "2TG 2TH 8GT= 8GT* 3NDDDKU", "[2T 8GT 3NDDDK]"},
+		{Scanner{Check: true}, "8GT=X 2T-G ABCDEFGHJKQ", "[ABCDEFGHJK]"},
+		{Scanner{MinDigits: 1}, "", "[]"},
+	}
+
+	for _, c := range cases {
+		var values []Base32
+		for _, match := range c.scanner.FindAll(c.input) {
+			values = append(values, match.Value)
+		}
+		if fmt.Sprint(values) != c.expected {
+			t.Errorf("Expected %+v.FindAll(%q) to be %s, got %v.", c.scanner, c.input, c.expected, values)
+		}
+	}
+
+	input := "Your order 8GT= ships today."
+	matches := (&Scanner{Check: true}).FindAll(input)
+	if len(matches) != 1 || input[matches[0].Start:matches[0].End] != "8GT=" || matches[0].Check != '=' {
+		t.Errorf("Expected FindAll(%q) to find \"8GT=\", got %+v.", input, matches)
+	}
+}
+
+func TestScanner_Split(t *testing.T) {
+	input := strings.Repeat("Order 8GT= and 2TG, not 2TH or CUT*. ", 100)
+
+	for _, reader := range []func(string) *bufio.Scanner{
+		func(s string) *bufio.Scanner { return bufio.NewScanner(strings.NewReader(s)) },
+		func(s string) *bufio.Scanner { return bufio.NewScanner(iotest.OneByteReader(strings.NewReader(s))) },
+	} {
+		scanner := reader(input)
+		scanner.Split((&Scanner{MinDigits: 2, Check: true}).Split)
+
+		var tokens []string
+		for scanner.Scan() {
+			tokens = append(tokens, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatalf("Expected Split to succeed, got error %q.", err)
+		}
+
+		if len(tokens) != 200 || tokens[0] != "8GT=" || tokens[1] != "2TG" {
+			t.Errorf("Expected Split to find 200 tokens starting with 8GT= and 2TG, got %d: %q.",
+				len(tokens), tokens[:2])
+		}
+	}
+}
+
+func BenchmarkScanner_FindAll(b *testing.B) {
+	scanner := &Scanner{MinDigits: 2, Check: true}
+	input := "Hello, your order 8GT= has shipped. Your coupon is 2TG, or ABCDEFGHJKQ."
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = scanner.FindAll(input)
+	}
+}
+
+func ExampleScanner() {
+	scanner := &Scanner{MinDigits: 2, Check: true}
+	input := "Your order 8gt= has shipped. (Not 8GT*.)"
+
+	for _, match := range scanner.FindAll(input) {
+		fmt.Println(match.Start, match.End, match.Value, match.Check)
+	}
+	// Output:
+	// 11 15 8GT =
+}
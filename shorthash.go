@@ -0,0 +1,91 @@
+package base32
+
+import (
+	"crypto/sha256"
+	"hash"
+	"math"
+)
+
+// ShortHash returns the first `digits` Base32 digits of the SHA-256 hash of
+// `data`, for use in cache keys, artifact names and the like:
+//
+//	ShortHash([]byte("hello"), 10) //=> "5KS4VEJZP2"
+//
+// Each digit is 5 bits of the hash, most significant first, so a ShortHash
+// with fewer digits is always a prefix of one with more. See
+// CollisionProbability for choosing the number of digits.
+//
+// ShortHash panics if `digits` isn't between 1 and 51, which is all 256 bits
+// of the hash that fit in whole digits.
+func ShortHash(data []byte, digits int) string {
+	sum := sha256.Sum256(data)
+	return shortHashDigits(sum[:], digits)
+}
+
+// A ShortHasher is an io.Writer that computes a ShortHash of everything
+// written to it, using any hash function:
+//
+//	hasher := NewShortHasher(nil, 10)
+//	io.Copy(hasher, file)
+//	name := hasher.Sum()
+type ShortHasher struct {
+	hash   hash.Hash
+	digits int
+}
+
+// NewShortHasher returns a ShortHasher that renders the first `digits` digits
+// of `h`. A nil `h` means SHA-256, which gives the same results as ShortHash.
+//
+// NewShortHasher panics if `digits` is less than 1, or more than the number of
+// whole digits in the hash (h.Size() * 8 / 5).
+func NewShortHasher(h hash.Hash, digits int) *ShortHasher {
+	if h == nil {
+		h = sha256.New()
+	}
+	if digits < 1 || digits > h.Size()*8/5 {
+		panic("base32: invalid number of ShortHash digits")
+	}
+	return &ShortHasher{hash: h, digits: digits}
+}
+
+// Write adds more data to the hash. It never returns an error.
+func (s *ShortHasher) Write(p []byte) (int, error) {
+	return s.hash.Write(p)
+}
+
+// Sum returns the short hash of the data written so far. It doesn't change
+// the state of the hasher, so more data can be written afterwards.
+func (s *ShortHasher) Sum() string {
+	return shortHashDigits(s.hash.Sum(nil), s.digits)
+}
+
+// Reset clears everything written so far.
+func (s *ShortHasher) Reset() {
+	s.hash.Reset()
+}
+
+// CollisionProbability returns the chance that at least two of `items`
+// distinct inputs have the same short hash of `digits` digits, assuming the
+// hash is uniformly random. It uses the birthday bound:
+//
+//	1 - e^(-n(n-1) / 2^(5 * digits + 1))
+//
+// For example, a million items with 8 digits (40 bits) have about a 37%
+// chance of a collision, and with 10 digits about 0.044%.
+func CollisionProbability(items uint64, digits int) float64 {
+	if items < 2 || digits < 0 {
+		return 0
+	}
+	n := float64(items)
+	space := math.Exp2(float64(5 * digits))
+	return -math.Expm1(-n * (n - 1) / (2 * space))
+}
+
+// shortHashDigits renders the first `digits` digits of `sum`.
+func shortHashDigits(sum []byte, digits int) string {
+	if digits < 1 || digits > len(sum)*8/5 {
+		panic("base32: invalid number of ShortHash digits")
+	}
+	// Only encode the bytes needed for the leading digits.
+	return EncodeBytes(sum[:(digits*5+7)/8])[:digits]
+}
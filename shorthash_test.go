@@ -0,0 +1,114 @@
+package base32
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestShortHash(t *testing.T) {
+	cases := []struct {
+		input    string
+		digits   int
+		expected string
+	}{
+		{"hello", 1, "5"},
+		{"hello", 10, "5KS4VEJZP2"},
+		{"", 51, "WERC8GMRZGE196QVYK49JVXS4GKTWGF4CJDS6K54JPCHPY2JQ1A"},
+	}
+	for _, c := range cases {
+		actual := ShortHash([]byte(c.input), c.digits)
+		if actual != c.expected {
+			t.Errorf("Expected ShortHash(%q, %d) to be %q, got %q.", c.input, c.digits, c.expected, actual)
+		}
+	}
+}
+
+func TestShortHash_Prefix(t *testing.T) {
+	full := ShortHash([]byte("hello"), 51)
+	for digits := 1; digits <= 51; digits++ {
+		if actual := ShortHash([]byte("hello"), digits); actual != full[:digits] {
+			t.Errorf("Expected ShortHash with %d digits to be %q, got %q.", digits, full[:digits], actual)
+		}
+	}
+}
+
+func TestShortHash_Panics(t *testing.T) {
+	for _, digits := range []int{0, -1, 52} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected ShortHash with %d digits to panic.", digits)
+				}
+			}()
+			ShortHash(nil, digits)
+		}()
+	}
+}
+
+func TestShortHasher(t *testing.T) {
+	hasher := NewShortHasher(nil, 10)
+	io.Copy(hasher, strings.NewReader("hel"))
+	hasher.Write([]byte("lo"))
+	if actual := hasher.Sum(); actual != "5KS4VEJZP2" {
+		t.Errorf("Expected Sum() to be %q, got %q.", "5KS4VEJZP2", actual)
+	}
+
+	hasher.Reset()
+	if actual, expected := hasher.Sum(), ShortHash(nil, 10); actual != expected {
+		t.Errorf("Expected Sum() after Reset to be %q, got %q.", expected, actual)
+	}
+
+	// MD5 of "hello" is 5d41402abc4b2a76b9719d911017c592.
+	md5Hasher := NewShortHasher(md5.New(), 25)
+	md5Hasher.Write([]byte("hello"))
+	if actual := md5Hasher.Sum(); actual != "BN0M0ANW9CN7DEBHKP8H05Y5J" {
+		t.Errorf("Expected the MD5 Sum() to be %q, got %q.", "BN0M0ANW9CN7DEBHKP8H05Y5J", actual)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected NewShortHasher with too many digits to panic.")
+		}
+	}()
+	NewShortHasher(md5.New(), 26)
+}
+
+func TestCollisionProbability(t *testing.T) {
+	cases := []struct {
+		items    uint64
+		digits   int
+		expected float64
+	}{
+		{0, 10, 0},
+		{1, 1, 0},
+		{2, 1, 1.0 / 32},
+		{1000000, 8, 0.3654},
+		{1000000, 10, 0.000444},
+		{1000000, 12, 4.337e-7},
+		{1 << 40, 4, 1},
+	}
+	for _, c := range cases {
+		actual := CollisionProbability(c.items, c.digits)
+		// The birthday bound is an approximation, so allow 5% of slack.
+		if math.Abs(actual-c.expected) > c.expected*0.05 {
+			t.Errorf("Expected CollisionProbability(%d, %d) to be about %g, got %g.", c.items, c.digits, c.expected, actual)
+		}
+	}
+}
+
+func BenchmarkShortHash(b *testing.B) {
+	b.ReportAllocs()
+	data := []byte(strings.Repeat("x", 1024))
+	for i := 0; i < b.N; i++ {
+		ShortHash(data, 12)
+	}
+}
+
+func ExampleShortHash() {
+	fmt.Println(ShortHash([]byte("hello"), 10))
+	// Output: 5KS4VEJZP2
+}
@@ -0,0 +1,95 @@
+package base32
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSignerKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestSigner_Verify(t *testing.T) {
+	signer := NewSigner(testSignerKey)
+	expiry := time.Now().Add(time.Hour)
+
+	for _, payload := range []uint64{0, 1, 90, maxUint64Value} {
+		token := signer.Sign(payload, expiry)
+		if len(token) != 32 {
+			t.Errorf("Expected Sign(%d) to be 32 digits long, got %q.", payload, token)
+		}
+
+		actual, err := signer.Verify(token)
+		if err != nil || actual != payload {
+			t.Errorf("Expected Verify(%q) to be %d, <nil>; got %d, %v.",
+				token, payload, actual, err)
+		}
+
+		// Hand-typed tokens are cleaned up.
+		sloppy := strings.ToLower(token[:16]) + "-" + token[16:]
+		sloppy = strings.Replace(sloppy, "0", "o", -1)
+		actual, err = signer.Verify(sloppy)
+		if err != nil || actual != payload {
+			t.Errorf("Expected Verify(%q) to be %d, <nil>; got %d, %v.",
+				sloppy, payload, actual, err)
+		}
+	}
+}
+
+func TestSigner_VerifyErrors(t *testing.T) {
+	signer := NewSigner(testSignerKey)
+	token := signer.Sign(90, time.Now().Add(time.Hour))
+
+	// Change one digit of the payload.
+	tampered := []byte(token)
+	if tampered[5] == 'A' {
+		tampered[5] = 'B'
+	} else {
+		tampered[5] = 'A'
+	}
+
+	cases := []struct {
+		signer *Signer
+		token  string
+		err    error
+	}{
+		{signer, "", ErrTokenMalformed},
+		{signer, token[:31], ErrTokenMalformed},
+		{signer, token + "0", ErrTokenMalformed},
+		{signer, token[:31] + "U", ErrTokenMalformed},
+		{signer, string(tampered), ErrTokenTampered},
+		{NewSigner([]byte("some other key")), token, ErrTokenTampered},
+		{signer, signer.Sign(90, time.Now().Add(-time.Hour)), ErrTokenExpired},
+		{signer, signer.Sign(90, time.Unix(-1000, 0)), ErrTokenExpired},
+	}
+
+	for _, c := range cases {
+		actual, err := c.signer.Verify(c.token)
+		if actual != 0 || err != c.err {
+			t.Errorf("Expected Verify(%q) to be 0, %v; got %d, %v.",
+				c.token, c.err, actual, err)
+		}
+	}
+}
+
+func BenchmarkSigner_Verify(b *testing.B) {
+	signer := NewSigner(testSignerKey)
+	token := signer.Sign(123123123, time.Now().Add(time.Hour))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = signer.Verify(token)
+	}
+}
+
+func ExampleSigner() {
+	signer := NewSigner([]byte("use at least 32 random bytes here"))
+
+	token := signer.Sign(90, time.Now().Add(15*time.Minute))
+	payload, err := signer.Verify(token)
+
+	fmt.Println(len(token), payload, err)
+	// Output:
+	// 32 90 <nil>
+}
@@ -0,0 +1,160 @@
+package base32
+
+import (
+	"errors"
+	"sort"
+)
+
+// The number of Base32 digits needed to hold the largest uintXX value.
+const (
+	SortableWidth32 = 7  // 35 bits
+	SortableWidth64 = 13 // 65 bits
+)
+
+var (
+	decodeSortableLength = errors.New("Wrong number of Base32 digits for a sortable value")
+	decodeTooBig64       = errors.New("Base 32 value is too big for a 64-bit unsigned integer")
+)
+
+// SortableEncode translates a uint64 into a 13-digit Base32 string. Unlike
+// Encode, the result is always zero-padded to the full width, so sorting the
+// strings sorts the numbers:
+//
+//	Encode(31)         //=> "Z"
+//	Encode(32)         //=> "10" (sorts before "Z")
+//	SortableEncode(31) //=> "000000000000Z"
+//	SortableEncode(32) //=> "0000000000010"
+func SortableEncode(num uint64) string {
+	var buffer [SortableWidth64]byte
+	encodeFixedWidth(buffer[:], num)
+	return string(buffer[:])
+}
+
+// SortableEncode32 is like SortableEncode, but for uint32 values. The result is
+// always 7 digits long.
+func SortableEncode32(num uint32) string {
+	var buffer [SortableWidth32]byte
+	encodeFixedWidth(buffer[:], uint64(num))
+	return string(buffer[:])
+}
+
+// SortableDecode is the opposite of SortableEncode and SortableEncode32. The
+// input must be exactly 7 or 13 digits long, and a 7-digit input must fit in a
+// uint32. Like Decode, letters are case insensitive, 'O' is read as '0', and
+// 'I' and 'L' are read as '1'.
+func SortableDecode(input string) (uint64, error) {
+	if len(input) != SortableWidth32 && len(input) != SortableWidth64 {
+		return 0, decodeSortableLength
+	}
+
+	result, err := decodeFixedWidth(input)
+	if err != nil {
+		return 0, err
+	}
+	if len(input) == SortableWidth32 && !Base32(input).WillFit() {
+		return 0, decodeTooBig32
+	}
+
+	return result, nil
+}
+
+// Compare compares the numeric values of two Base32 numbers, without decoding
+// them, so there is no limit on their length. It returns -1 if num < other, 0
+// if they are equal, and +1 if num > other.
+//
+// Leading zeros are ignored, and the common input errors are handled like
+// Decode does, so Base32("00z").Compare("Z") is 0. Both values are assumed to
+// be valid. If not, the result of this method is undefined.
+func (num Base32) Compare(other Base32) int {
+	num = Trim(string(num))
+	other = Trim(string(other))
+
+	// With no leading zeros, the longer number is bigger.
+	if len(num) != len(other) {
+		if len(num) < len(other) {
+			return -1
+		}
+		return 1
+	}
+
+	for i := 0; i < len(num); i++ {
+		a, b := digitValue(num[i]), digitValue(other[i])
+		if a < b {
+			return -1
+		}
+		if a > b {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// Base32Slice attaches the methods of sort.Interface to []Base32, sorting in
+// increasing numeric order (see Base32.Compare).
+type Base32Slice []Base32
+
+func (s Base32Slice) Len() int           { return len(s) }
+func (s Base32Slice) Less(i, j int) bool { return s[i].Compare(s[j]) < 0 }
+func (s Base32Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// SortBase32 sorts a slice of Base32 values in increasing numeric order.
+func SortBase32(values []Base32) {
+	sort.Sort(Base32Slice(values))
+}
+
+// encodeFixedWidth fills `buffer` with the Base32 digits of `num`, zero-padded
+// on the left. The buffer must be wide enough to hold `num`.
+func encodeFixedWidth(buffer []byte, num uint64) {
+	const fiveOnes uint64 = 31 // Binary 11111
+	for i := len(buffer) - 1; i >= 0; i-- {
+		buffer[i] = encodingValue[num&fiveOnes]
+		num >>= 5
+	}
+}
+
+// decodeFixedWidth decodes a Base32 number of up to 13 digits, including
+// leading zeros, into a uint64.
+func decodeFixedWidth(input string) (uint64, error) {
+	if len(input) == 0 {
+		return 0, decodeEmptyString
+	}
+
+	result, consumed, _, err := decodeDigits64(input, false)
+	if err != nil {
+		return 0, err
+	}
+	if consumed != len(input) {
+		return 0, decodeInvalidDigit
+	}
+
+	return result, nil
+}
+
+// decodeDigits64 reads the Base32 digits at the start of `input` into a
+// uint64, stopping at the first character that isn't a digit. If `hyphens` is
+// true, hyphens are skipped instead. It returns the value, the number of bytes
+// read, and the number of digits among them. An error is returned only if the
+// digits make a number too big for a uint64.
+func decodeDigits64(input string, hyphens bool) (value uint64, consumed, digits int, err error) {
+	for ; consumed < len(input); consumed++ {
+		if hyphens && input[consumed] == '-' {
+			continue
+		}
+
+		val := digitValue(input[consumed])
+		if val == invalidDecodeValue {
+			break
+		}
+
+		// Shifting out any of the top 5 bits means the value doesn't fit.
+		if value>>59 != 0 {
+			return 0, 0, 0, decodeTooBig64
+		}
+
+		value = value<<5 | uint64(val)
+		digits++
+	}
+
+	return value, consumed, digits, nil
+}
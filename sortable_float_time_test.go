@@ -0,0 +1,128 @@
+package base32
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestEncodeFloat64(t *testing.T) {
+	values := []float64{
+		math.Inf(-1), -math.MaxFloat64, -1e300, -90, -1, -math.SmallestNonzeroFloat64,
+		math.Copysign(0, -1), 0, math.SmallestNonzeroFloat64, 1, 90, 1e300,
+		math.MaxFloat64, math.Inf(1),
+	}
+
+	var encoded []string
+	for _, f := range values {
+		s := EncodeFloat64(f)
+		if len(s) != SortableWidth64 {
+			t.Errorf("Expected EncodeFloat64(%v) to be 13 digits, got %q.", f, s)
+		}
+		encoded = append(encoded, s)
+
+		decoded, err := DecodeFloat64(s)
+		if err != nil || math.Float64bits(decoded) != math.Float64bits(f) {
+			t.Errorf("Expected DecodeFloat64(%q) to be %v, <nil>; got %v, %v.", s, f, decoded, err)
+		}
+	}
+
+	if !sort.StringsAreSorted(encoded) {
+		t.Errorf("Expected EncodeFloat64 values to sort in numeric order, got %q.", encoded)
+	}
+
+	for i := 0; i < 10000; i++ {
+		a := rand.NormFloat64() * math.Pow(10, float64(rand.Intn(40)-20))
+		b := rand.NormFloat64() * math.Pow(10, float64(rand.Intn(40)-20))
+		if (a < b) != (EncodeFloat64(a) < EncodeFloat64(b)) {
+			t.Fatalf("Expected EncodeFloat64(%v) and EncodeFloat64(%v) to sort numerically.", a, b)
+		}
+	}
+
+	if decoded, _ := DecodeFloat64(EncodeFloat64(math.NaN())); !math.IsNaN(decoded) {
+		t.Errorf("Expected NaN to round-trip, got %v.", decoded)
+	}
+	if _, err := DecodeFloat64("000002T"); err != decodeSortableLength {
+		t.Errorf("Expected DecodeFloat64 of 7 digits to return %v, got %v.", decodeSortableLength, err)
+	}
+}
+
+func TestEncodeTime(t *testing.T) {
+	times := []time.Time{
+		time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1969, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+		time.Unix(0, 0),
+		time.Date(2013, time.December, 3, 12, 30, 45, 123456789, time.UTC),
+		time.Date(2013, time.December, 3, 12, 30, 45, 123456790, time.UTC),
+		time.Date(2200, time.June, 1, 0, 0, 0, 0, time.FixedZone("X", 3600)),
+	}
+
+	precisions := []struct {
+		precision TimePrecision
+		unit      time.Duration
+	}{
+		{TimeSeconds, time.Second},
+		{TimeMilliseconds, time.Millisecond},
+		{TimeMicroseconds, time.Microsecond},
+		{TimeNanoseconds, time.Nanosecond},
+	}
+
+	for _, p := range precisions {
+		var encoded []string
+		for _, tm := range times {
+			s, err := EncodeTime(tm, p.precision)
+			if err != nil {
+				t.Errorf("Expected EncodeTime(%v, %d) to succeed, got %v.", tm, p.precision, err)
+			}
+			encoded = append(encoded, s)
+
+			expected := tm.Truncate(p.unit).UTC()
+			if tm.Before(time.Unix(0, 0)) && !tm.Equal(tm.Truncate(p.unit)) {
+				// Truncate rounds toward the zero time, but EncodeTime
+				// rounds down from the Unix epoch.
+				expected = time.Unix(0, 0).Add(tm.Sub(time.Unix(0, 0)).Truncate(p.unit) - p.unit).UTC()
+			}
+
+			decoded, err := DecodeTime(s, p.precision)
+			if err != nil || !decoded.Equal(expected) {
+				t.Errorf("Expected DecodeTime(%q, %d) to be %v, <nil>; got %v, %v.",
+					s, p.precision, expected, decoded, err)
+			}
+		}
+		if !sort.StringsAreSorted(encoded) {
+			t.Errorf("Expected EncodeTime(%d) values to sort in time order, got %q.", p.precision, encoded)
+		}
+	}
+}
+
+func TestEncodeTime_InvalidPrecision(t *testing.T) {
+	tm := time.Date(2013, time.December, 3, 12, 30, 45, 0, time.UTC)
+	for _, precision := range []TimePrecision{-1, TimeNanoseconds + 1} {
+		if s, err := EncodeTime(tm, precision); err != timeInvalidPrecision {
+			t.Errorf("Expected EncodeTime(%v, %d) to be \"\", %v; got %q, %v.",
+				tm, precision, timeInvalidPrecision, s, err)
+		}
+		if decoded, err := DecodeTime("800018AW70V88", precision); err != timeInvalidPrecision {
+			t.Errorf("Expected DecodeTime(%q, %d) to fail with %v, got %v, %v.",
+				"800018AW70V88", precision, timeInvalidPrecision, decoded, err)
+		}
+	}
+}
+
+func ExampleEncodeTime() {
+	t := time.Date(2013, time.December, 3, 12, 30, 45, 0, time.UTC)
+
+	when, _ := EncodeTime(t, TimeMilliseconds)
+	key := when + EncodeFloat64(98.6)
+	fmt.Println(key)
+
+	decoded, _ := DecodeTime(key[:13], TimeMilliseconds)
+	score, _ := DecodeFloat64(key[13:])
+	fmt.Println(decoded, score)
+	// Output:
+	// 800018AW70V88C0P56CSK6CSK6
+	// 2013-12-03 12:30:45 +0000 UTC 98.6
+}
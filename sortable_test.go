@@ -0,0 +1,162 @@
+package base32
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSortableEncode(t *testing.T) {
+	cases := []struct {
+		input    uint64
+		expected string
+	}{
+		{0, "0000000000000"},
+		{90, "000000000002T"},
+		{maxUint64Value, "FZZZZZZZZZZZZ"},
+	}
+
+	for _, c := range cases {
+		actual := SortableEncode(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected SortableEncode(%d) to be %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+
+	cases32 := []struct {
+		input    uint32
+		expected string
+	}{
+		{0, "0000000"},
+		{90, "000002T"},
+		{maxUint32Value, "3ZZZZZZ"},
+	}
+
+	for _, c := range cases32 {
+		actual := SortableEncode32(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected SortableEncode32(%d) to be %q, got %q.", c.input, c.expected, actual)
+		}
+	}
+
+	// String order must match numeric order.
+	for i := 0; i < 10000; i++ {
+		a, b := uint64(rand.Int63()), uint64(rand.Int63())>>uint(rand.Intn(64))
+		if (a < b) != (SortableEncode(a) < SortableEncode(b)) {
+			t.Fatalf("Expected SortableEncode(%d) and SortableEncode(%d) to sort numerically.", a, b)
+		}
+	}
+}
+
+func TestSortableDecode(t *testing.T) {
+	valid := []struct {
+		input    string
+		expected uint64
+	}{
+		{"000002t", 90},
+		{"3ZZZZZZ", uint64(maxUint32Value)},
+		{"ooooooooooo2T", 90},
+		{"FZZZZZZZZZZZZ", maxUint64Value},
+	}
+
+	for _, c := range valid {
+		actual, err := SortableDecode(c.input)
+		if err != nil || actual != c.expected {
+			t.Errorf("Expected SortableDecode(%q) to be %d, <nil>; got %d, %v.",
+				c.input, c.expected, actual, err)
+		}
+	}
+
+	invalid := []struct {
+		input string
+		err   error
+	}{
+		{"", decodeSortableLength},
+		{"2T", decodeSortableLength},
+		{"00000U0", decodeInvalidDigit},
+		{"4000000", decodeTooBig32},
+		{"ZZZZZZZ", decodeTooBig32},
+		{"G000000000000", decodeTooBig64},
+	}
+
+	for _, c := range invalid {
+		_, err := SortableDecode(c.input)
+		if err != c.err {
+			t.Errorf("Expected SortableDecode(%q) to return %v, got %v.", c.input, c.err, err)
+		}
+	}
+}
+
+func TestBase32_Compare(t *testing.T) {
+	cases := []struct {
+		a, b     Base32
+		expected int
+	}{
+		{"Z", "10", -1},
+		{"10", "Z", 1},
+		{"00z", "Z", 0},
+		{"0", "000", 0},
+		{"o", "0", 0},
+		{"1", "L", 0},
+		{"ZZZZZZZZZZZZZZZZZZZZ", "100000000000000000000", -1},
+		{"ABC", "ABD", -1},
+	}
+
+	for _, c := range cases {
+		actual := c.a.Compare(c.b)
+		if actual != c.expected {
+			t.Errorf("Expected %q.Compare(%q) to be %d, got %d.", c.a, c.b, c.expected, actual)
+		}
+	}
+
+	for i := 0; i < 10000; i++ {
+		a, b := rand.Uint32(), rand.Uint32()>>uint(rand.Intn(32))
+		var expected int
+		if a < b {
+			expected = -1
+		} else if a > b {
+			expected = 1
+		}
+		if actual := Encode(a).Compare(Encode(b)); actual != expected {
+			t.Fatalf("Expected %q.Compare(%q) to be %d, got %d.", Encode(a), Encode(b), expected, actual)
+		}
+	}
+}
+
+func TestSortBase32(t *testing.T) {
+	values := []Base32{"Z", "10", "0", "2T", "00A"}
+	SortBase32(values)
+	if fmt.Sprint(values) != "[0 00A Z 10 2T]" {
+		t.Errorf("Expected SortBase32 to be [0 00A Z 10 2T], got %v.", values)
+	}
+	if !sort.IsSorted(Base32Slice(values)) {
+		t.Errorf("Expected %v to be sorted.", values)
+	}
+}
+
+func BenchmarkSortableEncode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = SortableEncode(123123123123)
+	}
+}
+
+func BenchmarkBase32_Compare(b *testing.B) {
+	x, y := Base32("3ZZZZZY"), Base32("3ZZZZZZ")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.Compare(y)
+	}
+}
+
+func ExampleSortableEncode() {
+	fmt.Println(Encode(31) < Encode(32))
+	fmt.Println(SortableEncode(31) < SortableEncode(32))
+	fmt.Println(SortableEncode(32))
+	// Output:
+	// false
+	// true
+	// 0000000000010
+}
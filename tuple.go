@@ -0,0 +1,82 @@
+package base32
+
+import (
+	"errors"
+)
+
+var (
+	decodeTupleTruncated = errors.New("Base32 tuple ends in the middle of a number")
+	decodeTupleLeading   = errors.New("Base32 tuple has a number with a leading zero")
+)
+
+// EncodeTuple packs several numbers into a single Base32 string without any
+// separators, so the numbers can be split apart again by DecodeTuple.
+//
+// Each number is written 4 bits per digit, most significant first. The last
+// digit of each number is one of the 16 "terminal" digits G through Z, and the
+// other digits are one of the 16 "continuation" digits 0 through F:
+//
+//	EncodeTuple([]uint64{0, 90, 1}) //=> "G5TH"
+//
+// Leading zeros are never written, so every tuple has exactly one encoding.
+// The empty tuple is the empty string.
+func EncodeTuple(nums []uint64) string {
+	var result = make([]byte, 0, len(nums)*2)
+	var buffer [16]byte
+
+	for _, num := range nums {
+		// Fill the buffer from the least significant nibble. The first one
+		// written is the terminal digit.
+		i := len(buffer) - 1
+		buffer[i] = encodingValue[16+num&15]
+		for num >>= 4; num != 0; num >>= 4 {
+			i--
+			buffer[i] = encodingValue[num&15]
+		}
+		result = append(result, buffer[i:]...)
+	}
+
+	return string(result)
+}
+
+// DecodeTuple is the opposite of EncodeTuple. Like Decode, letters are case
+// insensitive, 'O' is read as '0', and 'I' and 'L' are read as '1'.
+//
+// An error is returned if the input has an invalid digit, ends with a
+// continuation digit, has a number with a leading zero (which EncodeTuple
+// never writes), or has a number that doesn't fit in a uint64. The empty
+// string decodes to an empty tuple.
+func DecodeTuple(input string) ([]uint64, error) {
+	var result []uint64
+	var num uint64
+	var digits = 0 // Digits in the current number so far.
+
+	for i := 0; i < len(input); i++ {
+		val := digitValue(input[i])
+		if val == invalidDecodeValue {
+			return nil, decodeInvalidDigit
+		}
+
+		nibble := uint64(val & 15)
+		if digits == 1 && num == 0 {
+			return nil, decodeTupleLeading
+		}
+		if num>>60 != 0 {
+			return nil, decodeTooBig64
+		}
+		num = num<<4 | nibble
+		digits++
+
+		if val >= 16 {
+			result = append(result, num)
+			num = 0
+			digits = 0
+		}
+	}
+
+	if digits != 0 {
+		return nil, decodeTupleTruncated
+	}
+
+	return result, nil
+}
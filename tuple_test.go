@@ -0,0 +1,92 @@
+package base32
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeTuple(t *testing.T) {
+	cases := []struct {
+		input    []uint64
+		expected string
+	}{
+		{nil, ""},
+		{[]uint64{0}, "G"},
+		{[]uint64{15}, "Z"},
+		{[]uint64{16}, "1G"},
+		{[]uint64{0, 90, 1}, "G5TH"},
+		{[]uint64{maxUint64Value}, "FFFFFFFFFFFFFFFZ"},
+	}
+
+	for _, c := range cases {
+		actual := EncodeTuple(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected EncodeTuple(%v) to be %q, got %q.", c.input, c.expected, actual)
+		}
+
+		decoded, err := DecodeTuple(actual)
+		if err != nil || fmt.Sprint(decoded) != fmt.Sprint(c.input) {
+			t.Errorf("Expected DecodeTuple(%q) to be %v, <nil>; got %v, %v.", actual, c.input, decoded, err)
+		}
+	}
+}
+
+func TestDecodeTuple(t *testing.T) {
+	decoded, err := DecodeTuple("g5th")
+	if err != nil || fmt.Sprint(decoded) != "[0 90 1]" {
+		t.Errorf("Expected DecodeTuple(\"g5th\") to be [0 90 1], <nil>; got %v, %v.", decoded, err)
+	}
+
+	invalid := []struct {
+		input string
+		err   error
+	}{
+		{"5", decodeTupleTruncated},
+		{"G5", decodeTupleTruncated},
+		{"0G", decodeTupleLeading},
+		{"G05M", decodeTupleLeading},
+		{"1FFFFFFFFFFFFFFFZ", decodeTooBig64},
+		{"GU", decodeInvalidDigit},
+		{"G-H", decodeInvalidDigit},
+	}
+
+	for _, c := range invalid {
+		_, err := DecodeTuple(c.input)
+		if err != c.err {
+			t.Errorf("Expected DecodeTuple(%q) to return %v, got %v.", c.input, c.err, err)
+		}
+	}
+
+	for i := 0; i < 10000; i++ {
+		input := make([]uint64, rand.Intn(5)+1)
+		for j := range input {
+			input[j] = uint64(rand.Int63()) >> uint(rand.Intn(64))
+		}
+
+		encoded := EncodeTuple(input)
+		decoded, err := DecodeTuple(encoded)
+		if err != nil || fmt.Sprint(decoded) != fmt.Sprint(input) {
+			t.Fatalf("Expected DecodeTuple(%q) to be %v, <nil>; got %v, %v.", encoded, input, decoded, err)
+		}
+	}
+}
+
+func BenchmarkEncodeTuple(b *testing.B) {
+	input := []uint64{123123123, 90, 0}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EncodeTuple(input)
+	}
+}
+
+func ExampleEncodeTuple() {
+	encoded := EncodeTuple([]uint64{0, 90, 1})
+	fmt.Println(encoded)
+
+	decoded, _ := DecodeTuple(encoded)
+	fmt.Println(decoded)
+	// Output:
+	// G5TH
+	// [0 90 1]
+}
@@ -0,0 +1,129 @@
+package base32
+
+import (
+	"errors"
+)
+
+// The number of Base32 digits needed to hold a 128-bit UUID. 26 digits hold 130
+// bits, so the most significant digit of an encoded UUID is at most 7.
+const uuidDigits = 26
+
+var (
+	decodeUUIDLength = errors.New("A Base32 UUID can't be more than 26 digits long, plus an optional check digit")
+	decodeUUIDTooBig = errors.New("Base 32 value is too big for a 128-bit UUID")
+	decodeUUIDCheck  = errors.New("The check digit does not match the UUID")
+)
+
+// EncodeUUID translates a UUID into a 26-digit Base32 string. The UUID is
+// treated as a 128-bit big-endian unsigned integer and, unlike Encode, the
+// result is always zero-padded to the full 26 digits.
+//
+// For example, the UUID 123e4567-e89b-12d3-a456-426614174000 is encoded as
+// "0J7S2PFT4V2B9T8NJ2CRA1EG00".
+func EncodeUUID(uuid [16]byte) string {
+	var buffer [uuidDigits]byte
+	encodeUUID(buffer[:], uuid)
+	return string(buffer[:])
+}
+
+// EncodeUUIDWithCheck is like EncodeUUID, but appends a check digit computed
+// over the full 128-bit value, for a total of 27 characters. See GenerateCheck
+// for details on check digits.
+func EncodeUUIDWithCheck(uuid [16]byte) string {
+	var buffer [uuidDigits + 1]byte
+	encodeUUID(buffer[:uuidDigits], uuid)
+	buffer[uuidDigits] = byte(checkOf(Base32(buffer[:uuidDigits])))
+	return string(buffer[:])
+}
+
+// DecodeUUID translates a Base32 string created by EncodeUUID or
+// EncodeUUIDWithCheck back into a UUID.
+//
+// The input is cleaned up the same way FromString does it: letters are case
+// insensitive, 'O' is read as '0', 'I' and 'L' are read as '1', and hyphens
+// are ignored. After removing hyphens, an input of up to 26 digits is
+// left-padded with zeros, like the output of Encode, and an input of 27
+// characters is 26 digits followed by a check digit. A check digit is verified
+// against the decoded UUID.
+func DecodeUUID(input string) (uuid [16]byte, err error) {
+
+	var digits [uuidDigits + 1]byte
+	var length int
+
+	for i := 0; i < len(input); i++ {
+		if input[i] == '-' {
+			continue
+		}
+		if length == len(digits) {
+			return uuid, decodeUUIDLength
+		}
+		digits[length] = input[i]
+		length++
+	}
+
+	if length == 0 {
+		return uuid, decodeEmptyString
+	}
+	if length < uuidDigits {
+		// Shift the digits to the right and fill in the leading zeros.
+		copy(digits[uuidDigits-length:], digits[:length])
+		for i := 0; i < uuidDigits-length; i++ {
+			digits[i] = '0'
+		}
+	}
+
+	// The most significant and the least significant 64 bits of the result.
+	var hi, lo uint64
+
+	for _, rn := range digits[:uuidDigits] {
+		val := digitValue(rn)
+		if val == invalidDecodeValue {
+			return uuid, decodeInvalidDigit
+		}
+
+		// Shifting out any of the top 5 bits means the value doesn't fit.
+		if hi>>59 != 0 {
+			return uuid, decodeUUIDTooBig
+		}
+
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(val)
+	}
+
+	for i := 0; i < 8; i++ {
+		uuid[i] = byte(hi >> uint(56-8*i))
+		uuid[i+8] = byte(lo >> uint(56-8*i))
+	}
+
+	if length == uuidDigits+1 {
+		check, err := CheckFromString(string(digits[uuidDigits]))
+		if err != nil {
+			return [16]byte{}, err
+		}
+		if check != checkOf(Base32(digits[:uuidDigits])) {
+			return [16]byte{}, decodeUUIDCheck
+		}
+	}
+
+	return uuid, nil
+}
+
+// encodeUUID writes the 26 Base32 digits of `uuid` into `buffer`, least
+// significant digit last.
+func encodeUUID(buffer []byte, uuid [16]byte) {
+	var hi, lo uint64
+	for i := 0; i < 8; i++ {
+		hi = hi<<8 | uint64(uuid[i])
+		lo = lo<<8 | uint64(uuid[i+8])
+	}
+
+	const fiveOnes uint64 = 31 // Binary 11111
+
+	// Peel off 5 bits at a time from the least significant end of the 128-bit
+	// value.
+	for i := uuidDigits - 1; i >= 0; i-- {
+		buffer[i] = encodingValue[lo&fiveOnes]
+		lo = lo>>5 | hi<<59
+		hi = hi >> 5
+	}
+}
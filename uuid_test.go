@@ -0,0 +1,157 @@
+package base32
+
+import (
+	crypto "crypto/rand"
+	"fmt"
+	"io"
+	"testing"
+)
+
+var exampleUUID = [16]byte{
+	0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3,
+	0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00,
+}
+
+var maxUUID = [16]byte{
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+}
+
+func TestEncodeUUID(t *testing.T) {
+	cases := []struct {
+		input     [16]byte
+		expected  string
+		withCheck string
+	}{
+		{[16]byte{}, "00000000000000000000000000", "000000000000000000000000000"},
+		{exampleUUID, "0J7S2PFT4V2B9T8NJ2CRA1EG00", "0J7S2PFT4V2B9T8NJ2CRA1EG00J"},
+		{maxUUID, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ*"},
+	}
+
+	for _, c := range cases {
+		actual := EncodeUUID(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected EncodeUUID(%x) to be %q, got %q.",
+				c.input, c.expected, actual)
+		}
+		actual = EncodeUUIDWithCheck(c.input)
+		if actual != c.withCheck {
+			t.Errorf("Expected EncodeUUIDWithCheck(%x) to be %q, got %q.",
+				c.input, c.withCheck, actual)
+		}
+	}
+}
+
+func TestDecodeUUID(t *testing.T) {
+	valid := []string{
+		"0J7S2PFT4V2B9T8NJ2CRA1EG00",
+		"J7S2PFT4V2B9T8NJ2CRA1EG00",
+		"0J7S2PFT4V2B9T8NJ2CRA1EG00J",
+		"0j7s2pft4v2b9t8nj2cra1eg00j",
+		"oJ7S2-PFT4V-2B9T8-NJ2CR-A1EGO-O",
+		"oJ7S2-PFT4V-2B9T8-NJ2CR-A1EGO-O-j",
+	}
+
+	for _, input := range valid {
+		actual, err := DecodeUUID(input)
+		if err != nil || actual != exampleUUID {
+			t.Errorf("Expected DecodeUUID(%q) to be %x, <nil>; got %x, %v.",
+				input, exampleUUID, actual, err)
+		}
+	}
+
+	invalid := []struct {
+		input string
+		err   error
+	}{
+		{"", decodeEmptyString},
+		{"---", decodeEmptyString},
+		{"0J7S2PFT4V2B9T8NJ2CRA1EG00JJ", decodeUUIDLength},
+		{"0J7S2PFT4V2B9T8NJ2CRA1EGU0", decodeInvalidDigit},
+		{"0J7S2PFT4V2B9T8NJ2CRA1EG!0", decodeInvalidDigit},
+		{"8ZZZZZZZZZZZZZZZZZZZZZZZZZ", decodeUUIDTooBig},
+		{"0J7S2PFT4V2B9T8NJ2CRA1EG00K", decodeUUIDCheck},
+		{"0J7S2PFT4V2B9T8NJ2CRA1EG00&", invalidCheckDigit},
+	}
+
+	short := map[string][16]byte{
+		"0":     {},
+		"-1-":   {15: 1},
+		"zz":    {15: 0xff, 14: 0x03},
+		"10000": {13: 0x10},
+	}
+
+	for input, expected := range short {
+		actual, err := DecodeUUID(input)
+		if err != nil || actual != expected {
+			t.Errorf("Expected DecodeUUID(%q) to be %x, <nil>; got %x, %v.",
+				input, expected, actual, err)
+		}
+	}
+
+	for _, c := range invalid {
+		_, err := DecodeUUID(c.input)
+		if err != c.err {
+			t.Errorf("Expected DecodeUUID(%q) to return error %v, got %v.",
+				c.input, c.err, err)
+		}
+	}
+}
+
+func TestEncodeDecodeUUID(t *testing.T) {
+	const n = 10000
+
+	for i := 0; i < n; i++ {
+		var uuid [16]byte
+		if _, err := io.ReadFull(crypto.Reader, uuid[:]); err != nil {
+			panic("Unable to generate random bytes.")
+		}
+
+		encoded := EncodeUUIDWithCheck(uuid)
+		decoded, err := DecodeUUID(encoded)
+		if err != nil {
+			t.Errorf("Expected DecodeUUID(%q) to succeed, got error %q.", encoded, err)
+		} else if decoded != uuid {
+			t.Errorf("Expected DecodeUUID(%q) to be %x, got %x.", encoded, uuid, decoded)
+		}
+	}
+}
+
+func BenchmarkEncodeUUID(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EncodeUUID(exampleUUID)
+	}
+}
+
+func BenchmarkDecodeUUID(b *testing.B) {
+	input := "0J7S2PFT4V2B9T8NJ2CRA1EG00J"
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = DecodeUUID(input)
+	}
+}
+
+func ExampleEncodeUUID() {
+	uuid := [16]byte{
+		0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3,
+		0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00,
+	}
+	fmt.Println(EncodeUUID(uuid))
+	fmt.Println(EncodeUUIDWithCheck(uuid))
+	// Output:
+	// 0J7S2PFT4V2B9T8NJ2CRA1EG00
+	// 0J7S2PFT4V2B9T8NJ2CRA1EG00J
+}
+
+func ExampleDecodeUUID() {
+	uuid, err := DecodeUUID("0j7s2-pft4v-2b9t8-nj2cr-a1eg0-0j")
+	if err != nil {
+		fmt.Println("Unable to decode UUID.")
+		return
+	}
+	fmt.Printf("%x\n", uuid)
+	// Output:
+	// 123e4567e89b12d3a456426614174000
+}
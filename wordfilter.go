@@ -0,0 +1,141 @@
+package base32
+
+import (
+	"strings"
+)
+
+// A WordFilter detects Base32 values that spell out unwanted words.
+//
+// Crockford's alphabet leaves out the letter 'U' to avoid some accidental
+// obscenity, but plenty of words can still be spelled with the remaining
+// letters, especially when digits are read as the letters they resemble
+// ("leet-speak"). For example, '0' reads as 'O', '1' as 'I' or 'L', '3' as
+// 'E', '5' as 'S', and 'V' as 'U'.
+//
+// A WordFilter is safe for concurrent use once it has been created.
+type WordFilter struct {
+	words []string
+}
+
+// The letters (and the digit itself) that each Base32 digit value can be read
+// as, indexed by the digit's decoded value.
+var wordFilterReadings = [32]string{
+	"0O", "1IL", "2Z", "3E", "4A", "5S", "6G", "7T", "8B", "9G",
+	"A", "B", "C", "D", "E", "F", "G", "H", "J", "K",
+	"M", "N", "P", "Q", "R", "S", "T", "VU", "W", "X",
+	"Y", "Z",
+}
+
+// NewWordFilter returns a WordFilter that rejects any Base32 value that
+// contains one of the given words.
+//
+// Words are case insensitive. Any character in a word that is not an ASCII
+// letter or digit is ignored, so "f-u-n" and "FUN" are the same word. Words
+// that are empty after that clean-up are ignored.
+func NewWordFilter(words ...string) *WordFilter {
+	filter := &WordFilter{words: make([]string, 0, len(words))}
+	for _, word := range words {
+		var normalized []byte
+		for i := 0; i < len(word); i++ {
+			char := word[i]
+			// Uppercase the characters, ASCII hack.
+			if char >= 'a' && char <= 'z' {
+				char -= 32
+			}
+			if (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9') {
+				normalized = append(normalized, char)
+			}
+		}
+		if len(normalized) > 0 {
+			filter.words = append(filter.words, string(normalized))
+		}
+	}
+	return filter
+}
+
+// Match returns the first blocked word found in `num`, and true, or the empty
+// string and false if `num` is clean.
+//
+// The common input errors that Decode allows (lowercase letters, 'O' for '0',
+// 'I' and 'L' for '1') are corrected before matching. Hyphens and other
+// invalid digits are treated as word breaks.
+func (f *WordFilter) Match(num Base32) (string, bool) {
+	for _, word := range f.words {
+		if wordFilterContains(num, word) {
+			return word, true
+		}
+	}
+	return "", false
+}
+
+// IsBlocked returns true if `num` contains one of the filter's words.
+func (f *WordFilter) IsBlocked(num Base32) bool {
+	_, found := f.Match(num)
+	return found
+}
+
+// Next returns the smallest value greater than or equal to `num` whose Base32
+// encoding is not blocked, along with that encoding. If every remaining uint32
+// value is blocked, Next returns 0, InvalidBase32Value and false.
+//
+// Next is meant for sequential ID generators:
+//
+//	value, code, ok := filter.Next(counter)
+//	counter = value + 1
+func (f *WordFilter) Next(num uint32) (uint32, Base32, bool) {
+	for {
+		encoded := Encode(num)
+		if !f.IsBlocked(encoded) {
+			return num, encoded, true
+		}
+		if num == maxUint32Value {
+			return 0, InvalidBase32Value, false
+		}
+		num++
+	}
+}
+
+// Filter wraps an integer generator, such as a random number source or a
+// counter, so that values whose Base32 encoding is blocked are skipped. The
+// returned function calls `next` until it gets a clean value.
+//
+// The generator must eventually produce a clean value or the returned function
+// will never return.
+func (f *WordFilter) Filter(next func() uint32) func() uint32 {
+	return func() uint32 {
+		for {
+			num := next()
+			if !f.IsBlocked(Encode(num)) {
+				return num
+			}
+		}
+	}
+}
+
+// wordFilterContains reports whether any run of digits in `num` can be read as
+// `word`. The word must already be normalized by NewWordFilter.
+func wordFilterContains(num Base32, word string) bool {
+	for start := 0; start+len(word) <= len(num); start++ {
+		matched := true
+		for i := 0; i < len(word); i++ {
+			if !wordFilterReads(num[start+i], word[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// wordFilterReads reports whether the Base32 digit `digit` can be read as the
+// character `char`.
+func wordFilterReads(digit byte, char byte) bool {
+	val := digitValue(digit)
+	if val == invalidDecodeValue {
+		return false
+	}
+	return strings.IndexByte(wordFilterReadings[val], char) >= 0
+}
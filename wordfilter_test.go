@@ -0,0 +1,97 @@
+package base32
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWordFilter_Match(t *testing.T) {
+	filter := NewWordFilter("fun", "B-O-O", "sad", "")
+
+	cases := []struct {
+		input    Base32
+		expected string
+		found    bool
+	}{
+		{"ABC", "", false},
+		{"FVN", "FUN", true},   // V reads as U
+		{"9FVN2", "FUN", true}, // Words can appear anywhere.
+		{"fvn", "FUN", true},   // Case insensitive.
+		{"B00", "BOO", true},   // 0 reads as O
+		{"Boo", "BOO", true},   // Letter O is corrected to 0, which reads as O
+		{"5AD", "SAD", true},   // 5 reads as S
+		{"FV-N", "", false},    // Hyphens break words.
+		{"FV", "", false},
+		{"", "", false},
+	}
+
+	for _, c := range cases {
+		word, found := filter.Match(c.input)
+		if word != c.expected || found != c.found {
+			t.Errorf("Expected Match(%q) to be %q, %t; got %q, %t.",
+				c.input, c.expected, c.found, word, found)
+		}
+	}
+}
+
+func TestWordFilter_Next(t *testing.T) {
+	filter := NewWordFilter("TV")
+
+	// "TT" through "TZ" are 858 through 863. "TV" is 859.
+	value, code, ok := filter.Next(859)
+	if value != 860 || code != "TW" || !ok {
+		t.Errorf("Expected Next(859) to be 860, \"TW\", true; got %d, %q, %t.",
+			value, code, ok)
+	}
+
+	value, code, ok = filter.Next(858)
+	if value != 858 || code != "TT" || !ok {
+		t.Errorf("Expected Next(858) to be 858, \"TT\", true; got %d, %q, %t.",
+			value, code, ok)
+	}
+
+	blockAll := NewWordFilter("3")
+	value, code, ok = blockAll.Next(maxUint32Value)
+	if value != 0 || code != InvalidBase32Value || ok {
+		t.Errorf("Expected Next(max) to fail, got %d, %q, %t.", value, code, ok)
+	}
+}
+
+func TestWordFilter_Filter(t *testing.T) {
+	filter := NewWordFilter("TV")
+
+	counter := uint32(858)
+	next := filter.Filter(func() uint32 {
+		counter++
+		return counter
+	})
+
+	// 859 ("TV") must be skipped.
+	for _, expected := range []uint32{860, 861} {
+		actual := next()
+		if actual != expected {
+			t.Errorf("Expected filtered generator to return %d, got %d.",
+				expected, actual)
+		}
+	}
+}
+
+func BenchmarkWordFilter_IsBlocked(b *testing.B) {
+	filter := NewWordFilter("FUN", "BOO", "SAD", "BAD", "MAD")
+	base32 := Base32("3ZZZZZZ")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = filter.IsBlocked(base32)
+	}
+}
+
+func ExampleWordFilter_Next() {
+	filter := NewWordFilter("TV")
+
+	value, code, _ := filter.Next(859) // 859 encodes to "TV".
+	fmt.Println(value, code)
+	// Output:
+	// 860 TW
+}